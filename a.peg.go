@@ -23,24 +23,88 @@ const (
 	ruleScale
 	ruleReverse
 	ruleCrop
-	ruleCropSub_P
-	ruleCropSub_Set
-	ruleCropSub_Key_Width
-	ruleCropSub_Key_Height
-	ruleCropSub_Key_X
-	ruleCropSub_Key_Y
 	ruleQuality
 	ruleExif
+	ruleStripGps
+	ruleAspectRatio
+	ruleBackground
+	ruleDpr
+	ruleBrightness
+	ruleContrast
+	ruleSaturation
+	ruleGamma
+	ruleHue
+	ruleMono
+	ruleSepia
+	ruleInvert
+	ruleDuotone
+	ruleBlur
+	ruleSharpen
+	rulePixelate
+	ruleRedact
+	ruleRedactMode
+	rulePad
+	rulePadSides
+	ruleBorder
+	ruleMark
+	ruleMarkWidth
+	ruleMarkAlign
+	ruleMarkPad
+	ruleMarkAlpha
+	ruleMarkScale
+	ruleText
+	ruleTextSize
+	ruleTextColor
+	ruleTextAlign
+	ruleTextPad
+	ruleTextFont
+	ruleCornerRadius
+	ruleMask
+	ruleTrim
+	ruleTrimTol
+	ruleTrimColor
+	ruleFilter
+	ruleUpscale
+	rulePalette
+	ruleColors
+	ruleLqip
+	rulePHash
+	ruleFrame
+	ruleMaxBytes
+	ruleLossless
+	ruleDither
+	ruleSignSeparator
 	ruleSkipParam
 	ruleSeparater
 	ruleDelimiter
 	ruleBool
 	ruleFitParam
 	ruleReverseParam
+	ruleRedactModeParam
+	ruleAlignParam
+	ruleTrimParam
+	ruleFilterParam
+	rulePaletteParam
+	ruleLqipParam
+	rulePHashParam
 	ruleOpen
 	ruleClose
+	ruleTuple_P
+	ruleTuple_Set
+	ruleTuple_Key_Width
+	ruleTuple_Key_Height
+	ruleTuple_Key_X
+	ruleTuple_Key_Y
+	ruleTuple_Key_Top
+	ruleTuple_Key_Right
+	ruleTuple_Key_Bottom
+	ruleTuple_Key_Left
 	ruleDigit
+	ruleSigned
 	ruleLowerCase
+	ruleHexColor
+	rulePath
+	ruleUrlText
 	ruleAll
 	ruleFormat_Key
 	ruleProgressive_Key
@@ -52,11 +116,63 @@ const (
 	ruleCrop_Key
 	ruleQuality_Key
 	ruleExif_Key
+	ruleStripGps_Key
+	ruleAspectRatio_Key
+	ruleBackground_Key
+	ruleDpr_Key
+	ruleBrightness_Key
+	ruleContrast_Key
+	ruleSaturation_Key
+	ruleGamma_Key
+	ruleHue_Key
+	ruleMono_Key
+	ruleSepia_Key
+	ruleInvert_Key
+	ruleDuotone_Key
+	ruleBlur_Key
+	ruleSharpen_Key
+	rulePixelate_Key
+	ruleRedact_Key
+	ruleRedactMode_Key
+	rulePad_Key
+	ruleBorder_Key
+	ruleMark_Key
+	ruleMarkWidth_Key
+	ruleMarkAlign_Key
+	ruleMarkPad_Key
+	ruleMarkAlpha_Key
+	ruleMarkScale_Key
+	ruleText_Key
+	ruleTextSize_Key
+	ruleTextColor_Key
+	ruleTextAlign_Key
+	ruleTextPad_Key
+	ruleTextFont_Key
+	ruleCornerRadius_Key
+	ruleMask_Key
+	ruleTrim_Key
+	ruleTrimTol_Key
+	ruleTrimColor_Key
+	ruleFilter_Key
+	ruleUpscale_Key
+	rulePalette_Key
+	ruleColors_Key
+	ruleLqip_Key
+	rulePHash_Key
+	ruleFrame_Key
+	ruleMaxBytes_Key
+	ruleLossless_Key
+	ruleDither_Key
+	ruleTop_Key
+	ruleRight_Key
+	ruleBottom_Key
+	ruleLeft_Key
 	ruleEqual
 	ruleQuestion
 	ruleAnd
 	ruleDot
 	ruleComma
+	ruleColon
 	ruleHaihun
 	ruleOpen_P
 	ruleClose_P
@@ -80,6 +196,60 @@ const (
 	ruleAction11
 	ruleAction12
 	ruleAction13
+	ruleAction14
+	ruleAction15
+	ruleAction16
+	ruleAction17
+	ruleAction18
+	ruleAction19
+	ruleAction20
+	ruleAction21
+	ruleAction22
+	ruleAction23
+	ruleAction24
+	ruleAction25
+	ruleAction26
+	ruleAction27
+	ruleAction28
+	ruleAction29
+	ruleAction30
+	ruleAction31
+	ruleAction32
+	ruleAction33
+	ruleAction34
+	ruleAction35
+	ruleAction36
+	ruleAction37
+	ruleAction38
+	ruleAction39
+	ruleAction40
+	ruleAction41
+	ruleAction42
+	ruleAction43
+	ruleAction44
+	ruleAction45
+	ruleAction46
+	ruleAction47
+	ruleAction48
+	ruleAction49
+	ruleAction50
+	ruleAction51
+	ruleAction52
+	ruleAction53
+	ruleAction54
+	ruleAction55
+	ruleAction56
+	ruleAction57
+	ruleAction58
+	ruleAction59
+	ruleAction60
+	ruleAction61
+	ruleAction62
+	ruleAction63
+	ruleAction64
+	ruleAction65
+	ruleAction66
+	ruleAction67
 )
 
 var rul3s = [...]string{
@@ -93,24 +263,88 @@ var rul3s = [...]string{
 	"Scale",
 	"Reverse",
 	"Crop",
-	"CropSub_P",
-	"CropSub_Set",
-	"CropSub_Key_Width",
-	"CropSub_Key_Height",
-	"CropSub_Key_X",
-	"CropSub_Key_Y",
 	"Quality",
 	"Exif",
+	"StripGps",
+	"AspectRatio",
+	"Background",
+	"Dpr",
+	"Brightness",
+	"Contrast",
+	"Saturation",
+	"Gamma",
+	"Hue",
+	"Mono",
+	"Sepia",
+	"Invert",
+	"Duotone",
+	"Blur",
+	"Sharpen",
+	"Pixelate",
+	"Redact",
+	"RedactMode",
+	"Pad",
+	"PadSides",
+	"Border",
+	"Mark",
+	"MarkWidth",
+	"MarkAlign",
+	"MarkPad",
+	"MarkAlpha",
+	"MarkScale",
+	"Text",
+	"TextSize",
+	"TextColor",
+	"TextAlign",
+	"TextPad",
+	"TextFont",
+	"CornerRadius",
+	"Mask",
+	"Trim",
+	"TrimTol",
+	"TrimColor",
+	"Filter",
+	"Upscale",
+	"Palette",
+	"Colors",
+	"Lqip",
+	"PHash",
+	"Frame",
+	"MaxBytes",
+	"Lossless",
+	"Dither",
+	"SignSeparator",
 	"SkipParam",
 	"Separater",
 	"Delimiter",
 	"Bool",
 	"FitParam",
 	"ReverseParam",
+	"RedactModeParam",
+	"AlignParam",
+	"TrimParam",
+	"FilterParam",
+	"PaletteParam",
+	"LqipParam",
+	"PHashParam",
 	"Open",
 	"Close",
+	"Tuple_P",
+	"Tuple_Set",
+	"Tuple_Key_Width",
+	"Tuple_Key_Height",
+	"Tuple_Key_X",
+	"Tuple_Key_Y",
+	"Tuple_Key_Top",
+	"Tuple_Key_Right",
+	"Tuple_Key_Bottom",
+	"Tuple_Key_Left",
 	"Digit",
+	"Signed",
 	"LowerCase",
+	"HexColor",
+	"Path",
+	"UrlText",
 	"All",
 	"Format_Key",
 	"Progressive_Key",
@@ -122,11 +356,63 @@ var rul3s = [...]string{
 	"Crop_Key",
 	"Quality_Key",
 	"Exif_Key",
+	"StripGps_Key",
+	"AspectRatio_Key",
+	"Background_Key",
+	"Dpr_Key",
+	"Brightness_Key",
+	"Contrast_Key",
+	"Saturation_Key",
+	"Gamma_Key",
+	"Hue_Key",
+	"Mono_Key",
+	"Sepia_Key",
+	"Invert_Key",
+	"Duotone_Key",
+	"Blur_Key",
+	"Sharpen_Key",
+	"Pixelate_Key",
+	"Redact_Key",
+	"RedactMode_Key",
+	"Pad_Key",
+	"Border_Key",
+	"Mark_Key",
+	"MarkWidth_Key",
+	"MarkAlign_Key",
+	"MarkPad_Key",
+	"MarkAlpha_Key",
+	"MarkScale_Key",
+	"Text_Key",
+	"TextSize_Key",
+	"TextColor_Key",
+	"TextAlign_Key",
+	"TextPad_Key",
+	"TextFont_Key",
+	"CornerRadius_Key",
+	"Mask_Key",
+	"Trim_Key",
+	"TrimTol_Key",
+	"TrimColor_Key",
+	"Filter_Key",
+	"Upscale_Key",
+	"Palette_Key",
+	"Colors_Key",
+	"Lqip_Key",
+	"PHash_Key",
+	"Frame_Key",
+	"MaxBytes_Key",
+	"Lossless_Key",
+	"Dither_Key",
+	"Top_Key",
+	"Right_Key",
+	"Bottom_Key",
+	"Left_Key",
 	"Equal",
 	"Question",
 	"And",
 	"Dot",
 	"Comma",
+	"Colon",
 	"Haihun",
 	"Open_P",
 	"Close_P",
@@ -150,6 +436,60 @@ var rul3s = [...]string{
 	"Action11",
 	"Action12",
 	"Action13",
+	"Action14",
+	"Action15",
+	"Action16",
+	"Action17",
+	"Action18",
+	"Action19",
+	"Action20",
+	"Action21",
+	"Action22",
+	"Action23",
+	"Action24",
+	"Action25",
+	"Action26",
+	"Action27",
+	"Action28",
+	"Action29",
+	"Action30",
+	"Action31",
+	"Action32",
+	"Action33",
+	"Action34",
+	"Action35",
+	"Action36",
+	"Action37",
+	"Action38",
+	"Action39",
+	"Action40",
+	"Action41",
+	"Action42",
+	"Action43",
+	"Action44",
+	"Action45",
+	"Action46",
+	"Action47",
+	"Action48",
+	"Action49",
+	"Action50",
+	"Action51",
+	"Action52",
+	"Action53",
+	"Action54",
+	"Action55",
+	"Action56",
+	"Action57",
+	"Action58",
+	"Action59",
+	"Action60",
+	"Action61",
+	"Action62",
+	"Action63",
+	"Action64",
+	"Action65",
+	"Action66",
+	"Action67",
 }
 
 type token32 struct {
@@ -262,12 +602,13 @@ func (t *tokens32) Tokens() []token32 {
 }
 
 type Peg struct {
-	Params     map[string]interface{}
-	CropParams map[string]interface{}
+	Params      map[string]interface{}
+	TupleParams map[string]interface{}
+	Skipped     []string
 
 	Buffer string
 	buffer []rune
-	rules  [67]func() bool
+	rules  [237]func() bool
 	parse  func(rule ...int) error
 	reset  func()
 	Pretty bool
@@ -374,19 +715,127 @@ func (p *Peg) Execute() {
 		case ruleAction6:
 			p.AddParam("reverse", text)
 		case ruleAction7:
-			p.AddCropSubParam("crop", "width", text)
+			p.AddTupleParam("crop")
 		case ruleAction8:
-			p.AddCropSubParam("crop", "height", text)
+			p.AddParam("quality", text)
 		case ruleAction9:
-			p.AddCropSubParam("crop", "x", text)
+			p.AddParam("exif", text)
 		case ruleAction10:
-			p.AddCropSubParam("crop", "y", text)
+			p.AddParam("strip-gps", text)
 		case ruleAction11:
-			p.AddParam("quality", text)
+			p.AddParam("ar", text)
 		case ruleAction12:
-			p.AddParam("exif", text)
+			p.AddParam("bg", text)
 		case ruleAction13:
+			p.AddParam("dpr", text)
+		case ruleAction14:
+			p.AddParam("bri", text)
+		case ruleAction15:
+			p.AddParam("con", text)
+		case ruleAction16:
+			p.AddParam("sat", text)
+		case ruleAction17:
+			p.AddParam("gam", text)
+		case ruleAction18:
+			p.AddParam("hue", text)
+		case ruleAction19:
+			p.AddParam("mono", text)
+		case ruleAction20:
+			p.AddParam("sepia", text)
+		case ruleAction21:
+			p.AddParam("invert", text)
+		case ruleAction22:
+			p.AddParam("duotone", text)
+		case ruleAction23:
+			p.AddParam("blur", text)
+		case ruleAction24:
+			p.AddParam("sharp", text)
+		case ruleAction25:
+			p.AddParam("px", text)
+		case ruleAction26:
+			p.AddTupleListParam("redact")
+		case ruleAction27:
+			p.AddParam("redact-mode", text)
+		case ruleAction28:
+			p.AddParam("pad", text)
+		case ruleAction29:
+			p.AddTupleParam("pad")
+		case ruleAction30:
+			p.AddParam("border", text)
+		case ruleAction31:
+			p.AddParam("mark", text)
+		case ruleAction32:
+			p.AddParam("mark-w", text)
+		case ruleAction33:
+			p.AddParam("mark-align", text)
+		case ruleAction34:
+			p.AddParam("mark-pad", text)
+		case ruleAction35:
+			p.AddParam("mark-alpha", text)
+		case ruleAction36:
+			p.AddParam("mark-scale", text)
+		case ruleAction37:
+			p.AddParam("txt", text)
+		case ruleAction38:
+			p.AddParam("txt-size", text)
+		case ruleAction39:
+			p.AddParam("txt-color", text)
+		case ruleAction40:
+			p.AddParam("txt-align", text)
+		case ruleAction41:
+			p.AddParam("txt-pad", text)
+		case ruleAction42:
+			p.AddParam("txt-font", text)
+		case ruleAction43:
+			p.AddParam("corner-radius", text)
+		case ruleAction44:
+			p.AddParam("mask", text)
+		case ruleAction45:
+			p.AddParam("trim", text)
+		case ruleAction46:
+			p.AddParam("trim-tol", text)
+		case ruleAction47:
+			p.AddParam("trim-color", text)
+		case ruleAction48:
+			p.AddParam("filter", text)
+		case ruleAction49:
+			p.AddParam("upscale", text)
+		case ruleAction50:
+			p.AddParam("palette", text)
+		case ruleAction51:
+			p.AddParam("colors", text)
+		case ruleAction52:
+			p.AddParam("lqip", text)
+		case ruleAction53:
+			p.AddParam("phash", text)
+		case ruleAction54:
+			p.AddParam("frame", text)
+		case ruleAction55:
+			p.AddParam("max-bytes", text)
+		case ruleAction56:
+			p.AddParam("lossless", text)
+		case ruleAction57:
+			p.AddParam("dither", text)
+		case ruleAction58:
+			p.AddParam("sign-separator", text)
+		case ruleAction59:
 			p.SkipParam(text)
+		case ruleAction60:
+			p.AddTupleSubParam("width", text)
+		case ruleAction61:
+			p.AddTupleSubParam("height", text)
+		case ruleAction62:
+			p.AddTupleSubParam("x", text)
+		case ruleAction63:
+			p.AddTupleSubParam("y", text)
+		case ruleAction64:
+			p.AddTupleSubParam("top", text)
+		case ruleAction65:
+			p.AddTupleSubParam("right", text)
+		case ruleAction66:
+			p.AddTupleSubParam("bottom", text)
+		case ruleAction67:
+			p.AddTupleSubParam("left", text)
 
 		}
 	}
@@ -461,7 +910,7 @@ func (p *Peg) Init() {
 
 	_rules = [...]func() bool{
 		nil,
-		/* 0 Expression <- <(((Delimiter Width) / (Delimiter Height) / (Delimiter Quality) / (Delimiter Format) / (Delimiter Crop) / (Delimiter Fit) / (Delimiter Scale) / (Delimiter Reverse) / (Delimiter Progressive) / (Delimiter Exif) / (Delimiter SkipParam) / Delimiter)+ EOF)> */
+		/* 0 Expression <- <(((Delimiter Width) / (Delimiter Height) / (Delimiter Quality) / (Delimiter Format) / (Delimiter Crop) / (Delimiter Fit) / (Delimiter Scale) / (Delimiter Reverse) / (Delimiter Progressive) / (Delimiter Exif) / (Delimiter StripGps) / (Delimiter AspectRatio) / (Delimiter Background) / (Delimiter Dpr) / (Delimiter Brightness) / (Delimiter Contrast) / (Delimiter Saturation) / (Delimiter Gamma) / (Delimiter Hue) / (Delimiter Mono) / (Delimiter Sepia) / (Delimiter Invert) / (Delimiter Duotone) / (Delimiter Blur) / (Delimiter Sharpen) / (Delimiter Pixelate) / (Delimiter Redact) / (Delimiter RedactMode) / (Delimiter Pad) / (Delimiter PadSides) / (Delimiter Border) / (Delimiter MarkWidth) / (Delimiter MarkAlign) / (Delimiter MarkPad) / (Delimiter MarkAlpha) / (Delimiter MarkScale) / (Delimiter Mark) / (Delimiter TextSize) / (Delimiter TextColor) / (Delimiter TextAlign) / (Delimiter TextPad) / (Delimiter TextFont) / (Delimiter Text) / (Delimiter CornerRadius) / (Delimiter Mask) / (Delimiter Trim) / (Delimiter TrimTol) / (Delimiter TrimColor) / (Delimiter Filter) / (Delimiter Upscale) / (Delimiter Palette) / (Delimiter Colors) / (Delimiter Lqip) / (Delimiter PHash) / (Delimiter Frame) / (Delimiter MaxBytes) / (Delimiter Lossless) / (Delimiter Dither) / (Delimiter SignSeparator) / (Delimiter SkipParam) / Delimiter)+ EOF)> */
 		func() bool {
 			position0, tokenIndex0 := position, tokenIndex
 			{
@@ -561,2433 +1010,9749 @@ func (p *Peg) Init() {
 					if !_rules[ruleDelimiter]() {
 						goto l15
 					}
-					if !_rules[ruleSkipParam]() {
+					if !_rules[ruleStripGps]() {
 						goto l15
 					}
 					goto l4
 				l15:
 					position, tokenIndex = position4, tokenIndex4
 					if !_rules[ruleDelimiter]() {
-						goto l0
-					}
-				}
-			l4:
-			l2:
-				{
-					position3, tokenIndex3 := position, tokenIndex
-					{
-						position16, tokenIndex16 := position, tokenIndex
-						if !_rules[ruleDelimiter]() {
-							goto l17
-						}
-						if !_rules[ruleWidth]() {
-							goto l17
-						}
-						goto l16
-					l17:
-						position, tokenIndex = position16, tokenIndex16
-						if !_rules[ruleDelimiter]() {
-							goto l18
-						}
-						if !_rules[ruleHeight]() {
-							goto l18
-						}
-						goto l16
-					l18:
-						position, tokenIndex = position16, tokenIndex16
-						if !_rules[ruleDelimiter]() {
-							goto l19
-						}
-						if !_rules[ruleQuality]() {
-							goto l19
-						}
-						goto l16
-					l19:
-						position, tokenIndex = position16, tokenIndex16
-						if !_rules[ruleDelimiter]() {
-							goto l20
-						}
-						if !_rules[ruleFormat]() {
-							goto l20
-						}
 						goto l16
-					l20:
-						position, tokenIndex = position16, tokenIndex16
-						if !_rules[ruleDelimiter]() {
-							goto l21
-						}
-						if !_rules[ruleCrop]() {
-							goto l21
-						}
-						goto l16
-					l21:
-						position, tokenIndex = position16, tokenIndex16
-						if !_rules[ruleDelimiter]() {
-							goto l22
-						}
-						if !_rules[ruleFit]() {
-							goto l22
-						}
-						goto l16
-					l22:
-						position, tokenIndex = position16, tokenIndex16
-						if !_rules[ruleDelimiter]() {
-							goto l23
-						}
-						if !_rules[ruleScale]() {
-							goto l23
-						}
-						goto l16
-					l23:
-						position, tokenIndex = position16, tokenIndex16
-						if !_rules[ruleDelimiter]() {
-							goto l24
-						}
-						if !_rules[ruleReverse]() {
-							goto l24
-						}
-						goto l16
-					l24:
-						position, tokenIndex = position16, tokenIndex16
-						if !_rules[ruleDelimiter]() {
-							goto l25
-						}
-						if !_rules[ruleProgressive]() {
-							goto l25
-						}
-						goto l16
-					l25:
-						position, tokenIndex = position16, tokenIndex16
-						if !_rules[ruleDelimiter]() {
-							goto l26
-						}
-						if !_rules[ruleExif]() {
-							goto l26
-						}
-						goto l16
-					l26:
-						position, tokenIndex = position16, tokenIndex16
-						if !_rules[ruleDelimiter]() {
-							goto l27
-						}
-						if !_rules[ruleSkipParam]() {
-							goto l27
-						}
+					}
+					if !_rules[ruleAspectRatio]() {
 						goto l16
-					l27:
-						position, tokenIndex = position16, tokenIndex16
-						if !_rules[ruleDelimiter]() {
-							goto l3
-						}
 					}
+					goto l4
 				l16:
-					goto l2
-				l3:
-					position, tokenIndex = position3, tokenIndex3
-				}
-				if !_rules[ruleEOF]() {
-					goto l0
-				}
-				add(ruleExpression, position1)
-			}
-			return true
-		l0:
-			position, tokenIndex = position0, tokenIndex0
-			return false
-		},
-		/* 1 Format <- <(Format_Key Separater <LowerCase> (&And / EOF) Action0)> */
-		func() bool {
-			position28, tokenIndex28 := position, tokenIndex
-			{
-				position29 := position
-				if !_rules[ruleFormat_Key]() {
-					goto l28
-				}
-				if !_rules[ruleSeparater]() {
-					goto l28
-				}
-				{
-					position30 := position
-					if !_rules[ruleLowerCase]() {
-						goto l28
-					}
-					add(rulePegText, position30)
-				}
-				{
-					position31, tokenIndex31 := position, tokenIndex
-					{
-						position33, tokenIndex33 := position, tokenIndex
-						if !_rules[ruleAnd]() {
-							goto l32
-						}
-						position, tokenIndex = position33, tokenIndex33
+					position, tokenIndex = position4, tokenIndex4
+					if !_rules[ruleDelimiter]() {
+						goto l17
 					}
-					goto l31
-				l32:
-					position, tokenIndex = position31, tokenIndex31
-					if !_rules[ruleEOF]() {
-						goto l28
+					if !_rules[ruleBackground]() {
+						goto l17
 					}
-				}
-			l31:
-				if !_rules[ruleAction0]() {
-					goto l28
-				}
-				add(ruleFormat, position29)
-			}
-			return true
-		l28:
-			position, tokenIndex = position28, tokenIndex28
-			return false
-		},
-		/* 2 Progressive <- <(Progressive_Key Separater <Bool> (&And / EOF) Action1)> */
-		func() bool {
-			position34, tokenIndex34 := position, tokenIndex
-			{
-				position35 := position
-				if !_rules[ruleProgressive_Key]() {
-					goto l34
-				}
-				if !_rules[ruleSeparater]() {
-					goto l34
-				}
-				{
-					position36 := position
-					if !_rules[ruleBool]() {
-						goto l34
+					goto l4
+				l17:
+					position, tokenIndex = position4, tokenIndex4
+					if !_rules[ruleDelimiter]() {
+						goto l18
 					}
-					add(rulePegText, position36)
-				}
-				{
-					position37, tokenIndex37 := position, tokenIndex
-					{
-						position39, tokenIndex39 := position, tokenIndex
-						if !_rules[ruleAnd]() {
-							goto l38
-						}
-						position, tokenIndex = position39, tokenIndex39
+					if !_rules[ruleDpr]() {
+						goto l18
 					}
-					goto l37
-				l38:
-					position, tokenIndex = position37, tokenIndex37
-					if !_rules[ruleEOF]() {
-						goto l34
+					goto l4
+				l18:
+					position, tokenIndex = position4, tokenIndex4
+					if !_rules[ruleDelimiter]() {
+						goto l19
 					}
-				}
-			l37:
-				if !_rules[ruleAction1]() {
-					goto l34
-				}
-				add(ruleProgressive, position35)
-			}
-			return true
-		l34:
-			position, tokenIndex = position34, tokenIndex34
-			return false
-		},
-		/* 3 Width <- <(Width_Key Separater <(Digit / Dot)+> (&And / EOF) Action2)> */
-		func() bool {
-			position40, tokenIndex40 := position, tokenIndex
-			{
-				position41 := position
-				if !_rules[ruleWidth_Key]() {
-					goto l40
-				}
-				if !_rules[ruleSeparater]() {
-					goto l40
-				}
-				{
-					position42 := position
-					{
-						position45, tokenIndex45 := position, tokenIndex
-						if !_rules[ruleDigit]() {
-							goto l46
-						}
-						goto l45
-					l46:
-						position, tokenIndex = position45, tokenIndex45
-						if !_rules[ruleDot]() {
-							goto l40
-						}
+					if !_rules[ruleBrightness]() {
+						goto l19
 					}
-				l45:
-				l43:
-					{
-						position44, tokenIndex44 := position, tokenIndex
-						{
-							position47, tokenIndex47 := position, tokenIndex
-							if !_rules[ruleDigit]() {
-								goto l48
-							}
-							goto l47
-						l48:
-							position, tokenIndex = position47, tokenIndex47
-							if !_rules[ruleDot]() {
-								goto l44
-							}
-						}
-					l47:
-						goto l43
-					l44:
-						position, tokenIndex = position44, tokenIndex44
+					goto l4
+				l19:
+					position, tokenIndex = position4, tokenIndex4
+					if !_rules[ruleDelimiter]() {
+						goto l20
 					}
-					add(rulePegText, position42)
-				}
-				{
-					position49, tokenIndex49 := position, tokenIndex
-					{
-						position51, tokenIndex51 := position, tokenIndex
-						if !_rules[ruleAnd]() {
-							goto l50
-						}
-						position, tokenIndex = position51, tokenIndex51
+					if !_rules[ruleContrast]() {
+						goto l20
 					}
-					goto l49
-				l50:
-					position, tokenIndex = position49, tokenIndex49
-					if !_rules[ruleEOF]() {
-						goto l40
+					goto l4
+				l20:
+					position, tokenIndex = position4, tokenIndex4
+					if !_rules[ruleDelimiter]() {
+						goto l21
 					}
-				}
-			l49:
-				if !_rules[ruleAction2]() {
-					goto l40
-				}
-				add(ruleWidth, position41)
-			}
-			return true
-		l40:
-			position, tokenIndex = position40, tokenIndex40
-			return false
-		},
-		/* 4 Height <- <(Height_Key Separater <(Digit / Dot)+> (&And / EOF) Action3)> */
-		func() bool {
-			position52, tokenIndex52 := position, tokenIndex
-			{
-				position53 := position
-				if !_rules[ruleHeight_Key]() {
-					goto l52
-				}
-				if !_rules[ruleSeparater]() {
-					goto l52
-				}
-				{
-					position54 := position
-					{
-						position57, tokenIndex57 := position, tokenIndex
-						if !_rules[ruleDigit]() {
-							goto l58
-						}
-						goto l57
-					l58:
-						position, tokenIndex = position57, tokenIndex57
-						if !_rules[ruleDot]() {
-							goto l52
-						}
+					if !_rules[ruleSaturation]() {
+						goto l21
 					}
-				l57:
-				l55:
-					{
-						position56, tokenIndex56 := position, tokenIndex
-						{
-							position59, tokenIndex59 := position, tokenIndex
-							if !_rules[ruleDigit]() {
-								goto l60
-							}
-							goto l59
-						l60:
-							position, tokenIndex = position59, tokenIndex59
-							if !_rules[ruleDot]() {
-								goto l56
-							}
-						}
-					l59:
-						goto l55
-					l56:
-						position, tokenIndex = position56, tokenIndex56
+					goto l4
+				l21:
+					position, tokenIndex = position4, tokenIndex4
+					if !_rules[ruleDelimiter]() {
+						goto l22
 					}
-					add(rulePegText, position54)
-				}
-				{
-					position61, tokenIndex61 := position, tokenIndex
-					{
-						position63, tokenIndex63 := position, tokenIndex
-						if !_rules[ruleAnd]() {
-							goto l62
-						}
-						position, tokenIndex = position63, tokenIndex63
+					if !_rules[ruleGamma]() {
+						goto l22
 					}
-					goto l61
-				l62:
-					position, tokenIndex = position61, tokenIndex61
-					if !_rules[ruleEOF]() {
-						goto l52
+					goto l4
+				l22:
+					position, tokenIndex = position4, tokenIndex4
+					if !_rules[ruleDelimiter]() {
+						goto l23
 					}
-				}
-			l61:
-				if !_rules[ruleAction3]() {
-					goto l52
-				}
-				add(ruleHeight, position53)
-			}
-			return true
-		l52:
-			position, tokenIndex = position52, tokenIndex52
-			return false
-		},
-		/* 5 Fit <- <(Fit_Key Separater <FitParam> (&And / EOF) Action4)> */
-		func() bool {
-			position64, tokenIndex64 := position, tokenIndex
-			{
-				position65 := position
-				if !_rules[ruleFit_Key]() {
-					goto l64
-				}
-				if !_rules[ruleSeparater]() {
-					goto l64
-				}
-				{
-					position66 := position
-					if !_rules[ruleFitParam]() {
-						goto l64
+					if !_rules[ruleHue]() {
+						goto l23
 					}
-					add(rulePegText, position66)
-				}
-				{
-					position67, tokenIndex67 := position, tokenIndex
-					{
-						position69, tokenIndex69 := position, tokenIndex
-						if !_rules[ruleAnd]() {
-							goto l68
-						}
-						position, tokenIndex = position69, tokenIndex69
+					goto l4
+				l23:
+					position, tokenIndex = position4, tokenIndex4
+					if !_rules[ruleDelimiter]() {
+						goto l24
 					}
-					goto l67
-				l68:
-					position, tokenIndex = position67, tokenIndex67
-					if !_rules[ruleEOF]() {
-						goto l64
+					if !_rules[ruleMono]() {
+						goto l24
 					}
-				}
-			l67:
+					goto l4
+				l24:
+					position, tokenIndex = position4, tokenIndex4
+					if !_rules[ruleDelimiter]() {
+						goto l25
+					}
+					if !_rules[ruleSepia]() {
+						goto l25
+					}
+					goto l4
+				l25:
+					position, tokenIndex = position4, tokenIndex4
+					if !_rules[ruleDelimiter]() {
+						goto l26
+					}
+					if !_rules[ruleInvert]() {
+						goto l26
+					}
+					goto l4
+				l26:
+					position, tokenIndex = position4, tokenIndex4
+					if !_rules[ruleDelimiter]() {
+						goto l27
+					}
+					if !_rules[ruleDuotone]() {
+						goto l27
+					}
+					goto l4
+				l27:
+					position, tokenIndex = position4, tokenIndex4
+					if !_rules[ruleDelimiter]() {
+						goto l28
+					}
+					if !_rules[ruleBlur]() {
+						goto l28
+					}
+					goto l4
+				l28:
+					position, tokenIndex = position4, tokenIndex4
+					if !_rules[ruleDelimiter]() {
+						goto l29
+					}
+					if !_rules[ruleSharpen]() {
+						goto l29
+					}
+					goto l4
+				l29:
+					position, tokenIndex = position4, tokenIndex4
+					if !_rules[ruleDelimiter]() {
+						goto l30
+					}
+					if !_rules[rulePixelate]() {
+						goto l30
+					}
+					goto l4
+				l30:
+					position, tokenIndex = position4, tokenIndex4
+					if !_rules[ruleDelimiter]() {
+						goto l31
+					}
+					if !_rules[ruleRedact]() {
+						goto l31
+					}
+					goto l4
+				l31:
+					position, tokenIndex = position4, tokenIndex4
+					if !_rules[ruleDelimiter]() {
+						goto l32
+					}
+					if !_rules[ruleRedactMode]() {
+						goto l32
+					}
+					goto l4
+				l32:
+					position, tokenIndex = position4, tokenIndex4
+					if !_rules[ruleDelimiter]() {
+						goto l33
+					}
+					if !_rules[rulePad]() {
+						goto l33
+					}
+					goto l4
+				l33:
+					position, tokenIndex = position4, tokenIndex4
+					if !_rules[ruleDelimiter]() {
+						goto l34
+					}
+					if !_rules[rulePadSides]() {
+						goto l34
+					}
+					goto l4
+				l34:
+					position, tokenIndex = position4, tokenIndex4
+					if !_rules[ruleDelimiter]() {
+						goto l35
+					}
+					if !_rules[ruleBorder]() {
+						goto l35
+					}
+					goto l4
+				l35:
+					position, tokenIndex = position4, tokenIndex4
+					if !_rules[ruleDelimiter]() {
+						goto l36
+					}
+					if !_rules[ruleMarkWidth]() {
+						goto l36
+					}
+					goto l4
+				l36:
+					position, tokenIndex = position4, tokenIndex4
+					if !_rules[ruleDelimiter]() {
+						goto l37
+					}
+					if !_rules[ruleMarkAlign]() {
+						goto l37
+					}
+					goto l4
+				l37:
+					position, tokenIndex = position4, tokenIndex4
+					if !_rules[ruleDelimiter]() {
+						goto l38
+					}
+					if !_rules[ruleMarkPad]() {
+						goto l38
+					}
+					goto l4
+				l38:
+					position, tokenIndex = position4, tokenIndex4
+					if !_rules[ruleDelimiter]() {
+						goto l39
+					}
+					if !_rules[ruleMarkAlpha]() {
+						goto l39
+					}
+					goto l4
+				l39:
+					position, tokenIndex = position4, tokenIndex4
+					if !_rules[ruleDelimiter]() {
+						goto l40
+					}
+					if !_rules[ruleMarkScale]() {
+						goto l40
+					}
+					goto l4
+				l40:
+					position, tokenIndex = position4, tokenIndex4
+					if !_rules[ruleDelimiter]() {
+						goto l41
+					}
+					if !_rules[ruleMark]() {
+						goto l41
+					}
+					goto l4
+				l41:
+					position, tokenIndex = position4, tokenIndex4
+					if !_rules[ruleDelimiter]() {
+						goto l42
+					}
+					if !_rules[ruleTextSize]() {
+						goto l42
+					}
+					goto l4
+				l42:
+					position, tokenIndex = position4, tokenIndex4
+					if !_rules[ruleDelimiter]() {
+						goto l43
+					}
+					if !_rules[ruleTextColor]() {
+						goto l43
+					}
+					goto l4
+				l43:
+					position, tokenIndex = position4, tokenIndex4
+					if !_rules[ruleDelimiter]() {
+						goto l44
+					}
+					if !_rules[ruleTextAlign]() {
+						goto l44
+					}
+					goto l4
+				l44:
+					position, tokenIndex = position4, tokenIndex4
+					if !_rules[ruleDelimiter]() {
+						goto l45
+					}
+					if !_rules[ruleTextPad]() {
+						goto l45
+					}
+					goto l4
+				l45:
+					position, tokenIndex = position4, tokenIndex4
+					if !_rules[ruleDelimiter]() {
+						goto l46
+					}
+					if !_rules[ruleTextFont]() {
+						goto l46
+					}
+					goto l4
+				l46:
+					position, tokenIndex = position4, tokenIndex4
+					if !_rules[ruleDelimiter]() {
+						goto l47
+					}
+					if !_rules[ruleText]() {
+						goto l47
+					}
+					goto l4
+				l47:
+					position, tokenIndex = position4, tokenIndex4
+					if !_rules[ruleDelimiter]() {
+						goto l48
+					}
+					if !_rules[ruleCornerRadius]() {
+						goto l48
+					}
+					goto l4
+				l48:
+					position, tokenIndex = position4, tokenIndex4
+					if !_rules[ruleDelimiter]() {
+						goto l49
+					}
+					if !_rules[ruleMask]() {
+						goto l49
+					}
+					goto l4
+				l49:
+					position, tokenIndex = position4, tokenIndex4
+					if !_rules[ruleDelimiter]() {
+						goto l50
+					}
+					if !_rules[ruleTrim]() {
+						goto l50
+					}
+					goto l4
+				l50:
+					position, tokenIndex = position4, tokenIndex4
+					if !_rules[ruleDelimiter]() {
+						goto l51
+					}
+					if !_rules[ruleTrimTol]() {
+						goto l51
+					}
+					goto l4
+				l51:
+					position, tokenIndex = position4, tokenIndex4
+					if !_rules[ruleDelimiter]() {
+						goto l52
+					}
+					if !_rules[ruleTrimColor]() {
+						goto l52
+					}
+					goto l4
+				l52:
+					position, tokenIndex = position4, tokenIndex4
+					if !_rules[ruleDelimiter]() {
+						goto l53
+					}
+					if !_rules[ruleFilter]() {
+						goto l53
+					}
+					goto l4
+				l53:
+					position, tokenIndex = position4, tokenIndex4
+					if !_rules[ruleDelimiter]() {
+						goto l54
+					}
+					if !_rules[ruleUpscale]() {
+						goto l54
+					}
+					goto l4
+				l54:
+					position, tokenIndex = position4, tokenIndex4
+					if !_rules[ruleDelimiter]() {
+						goto l55
+					}
+					if !_rules[rulePalette]() {
+						goto l55
+					}
+					goto l4
+				l55:
+					position, tokenIndex = position4, tokenIndex4
+					if !_rules[ruleDelimiter]() {
+						goto l56
+					}
+					if !_rules[ruleColors]() {
+						goto l56
+					}
+					goto l4
+				l56:
+					position, tokenIndex = position4, tokenIndex4
+					if !_rules[ruleDelimiter]() {
+						goto l57
+					}
+					if !_rules[ruleLqip]() {
+						goto l57
+					}
+					goto l4
+				l57:
+					position, tokenIndex = position4, tokenIndex4
+					if !_rules[ruleDelimiter]() {
+						goto l58
+					}
+					if !_rules[rulePHash]() {
+						goto l58
+					}
+					goto l4
+				l58:
+					position, tokenIndex = position4, tokenIndex4
+					if !_rules[ruleDelimiter]() {
+						goto l59
+					}
+					if !_rules[ruleFrame]() {
+						goto l59
+					}
+					goto l4
+				l59:
+					position, tokenIndex = position4, tokenIndex4
+					if !_rules[ruleDelimiter]() {
+						goto l60
+					}
+					if !_rules[ruleMaxBytes]() {
+						goto l60
+					}
+					goto l4
+				l60:
+					position, tokenIndex = position4, tokenIndex4
+					if !_rules[ruleDelimiter]() {
+						goto l61
+					}
+					if !_rules[ruleLossless]() {
+						goto l61
+					}
+					goto l4
+				l61:
+					position, tokenIndex = position4, tokenIndex4
+					if !_rules[ruleDelimiter]() {
+						goto l62
+					}
+					if !_rules[ruleDither]() {
+						goto l62
+					}
+					goto l4
+				l62:
+					position, tokenIndex = position4, tokenIndex4
+					if !_rules[ruleDelimiter]() {
+						goto l63
+					}
+					if !_rules[ruleSignSeparator]() {
+						goto l63
+					}
+					goto l4
+				l63:
+					position, tokenIndex = position4, tokenIndex4
+					if !_rules[ruleDelimiter]() {
+						goto l64
+					}
+					if !_rules[ruleSkipParam]() {
+						goto l64
+					}
+					goto l4
+				l64:
+					position, tokenIndex = position4, tokenIndex4
+					if !_rules[ruleDelimiter]() {
+						goto l0
+					}
+				}
+			l4:
+			l2:
+				{
+					position3, tokenIndex3 := position, tokenIndex
+					{
+						position65, tokenIndex65 := position, tokenIndex
+						if !_rules[ruleDelimiter]() {
+							goto l66
+						}
+						if !_rules[ruleWidth]() {
+							goto l66
+						}
+						goto l65
+					l66:
+						position, tokenIndex = position65, tokenIndex65
+						if !_rules[ruleDelimiter]() {
+							goto l67
+						}
+						if !_rules[ruleHeight]() {
+							goto l67
+						}
+						goto l65
+					l67:
+						position, tokenIndex = position65, tokenIndex65
+						if !_rules[ruleDelimiter]() {
+							goto l68
+						}
+						if !_rules[ruleQuality]() {
+							goto l68
+						}
+						goto l65
+					l68:
+						position, tokenIndex = position65, tokenIndex65
+						if !_rules[ruleDelimiter]() {
+							goto l69
+						}
+						if !_rules[ruleFormat]() {
+							goto l69
+						}
+						goto l65
+					l69:
+						position, tokenIndex = position65, tokenIndex65
+						if !_rules[ruleDelimiter]() {
+							goto l70
+						}
+						if !_rules[ruleCrop]() {
+							goto l70
+						}
+						goto l65
+					l70:
+						position, tokenIndex = position65, tokenIndex65
+						if !_rules[ruleDelimiter]() {
+							goto l71
+						}
+						if !_rules[ruleFit]() {
+							goto l71
+						}
+						goto l65
+					l71:
+						position, tokenIndex = position65, tokenIndex65
+						if !_rules[ruleDelimiter]() {
+							goto l72
+						}
+						if !_rules[ruleScale]() {
+							goto l72
+						}
+						goto l65
+					l72:
+						position, tokenIndex = position65, tokenIndex65
+						if !_rules[ruleDelimiter]() {
+							goto l73
+						}
+						if !_rules[ruleReverse]() {
+							goto l73
+						}
+						goto l65
+					l73:
+						position, tokenIndex = position65, tokenIndex65
+						if !_rules[ruleDelimiter]() {
+							goto l74
+						}
+						if !_rules[ruleProgressive]() {
+							goto l74
+						}
+						goto l65
+					l74:
+						position, tokenIndex = position65, tokenIndex65
+						if !_rules[ruleDelimiter]() {
+							goto l75
+						}
+						if !_rules[ruleExif]() {
+							goto l75
+						}
+						goto l65
+					l75:
+						position, tokenIndex = position65, tokenIndex65
+						if !_rules[ruleDelimiter]() {
+							goto l76
+						}
+						if !_rules[ruleStripGps]() {
+							goto l76
+						}
+						goto l65
+					l76:
+						position, tokenIndex = position65, tokenIndex65
+						if !_rules[ruleDelimiter]() {
+							goto l77
+						}
+						if !_rules[ruleAspectRatio]() {
+							goto l77
+						}
+						goto l65
+					l77:
+						position, tokenIndex = position65, tokenIndex65
+						if !_rules[ruleDelimiter]() {
+							goto l78
+						}
+						if !_rules[ruleBackground]() {
+							goto l78
+						}
+						goto l65
+					l78:
+						position, tokenIndex = position65, tokenIndex65
+						if !_rules[ruleDelimiter]() {
+							goto l79
+						}
+						if !_rules[ruleDpr]() {
+							goto l79
+						}
+						goto l65
+					l79:
+						position, tokenIndex = position65, tokenIndex65
+						if !_rules[ruleDelimiter]() {
+							goto l80
+						}
+						if !_rules[ruleBrightness]() {
+							goto l80
+						}
+						goto l65
+					l80:
+						position, tokenIndex = position65, tokenIndex65
+						if !_rules[ruleDelimiter]() {
+							goto l81
+						}
+						if !_rules[ruleContrast]() {
+							goto l81
+						}
+						goto l65
+					l81:
+						position, tokenIndex = position65, tokenIndex65
+						if !_rules[ruleDelimiter]() {
+							goto l82
+						}
+						if !_rules[ruleSaturation]() {
+							goto l82
+						}
+						goto l65
+					l82:
+						position, tokenIndex = position65, tokenIndex65
+						if !_rules[ruleDelimiter]() {
+							goto l83
+						}
+						if !_rules[ruleGamma]() {
+							goto l83
+						}
+						goto l65
+					l83:
+						position, tokenIndex = position65, tokenIndex65
+						if !_rules[ruleDelimiter]() {
+							goto l84
+						}
+						if !_rules[ruleHue]() {
+							goto l84
+						}
+						goto l65
+					l84:
+						position, tokenIndex = position65, tokenIndex65
+						if !_rules[ruleDelimiter]() {
+							goto l85
+						}
+						if !_rules[ruleMono]() {
+							goto l85
+						}
+						goto l65
+					l85:
+						position, tokenIndex = position65, tokenIndex65
+						if !_rules[ruleDelimiter]() {
+							goto l86
+						}
+						if !_rules[ruleSepia]() {
+							goto l86
+						}
+						goto l65
+					l86:
+						position, tokenIndex = position65, tokenIndex65
+						if !_rules[ruleDelimiter]() {
+							goto l87
+						}
+						if !_rules[ruleInvert]() {
+							goto l87
+						}
+						goto l65
+					l87:
+						position, tokenIndex = position65, tokenIndex65
+						if !_rules[ruleDelimiter]() {
+							goto l88
+						}
+						if !_rules[ruleDuotone]() {
+							goto l88
+						}
+						goto l65
+					l88:
+						position, tokenIndex = position65, tokenIndex65
+						if !_rules[ruleDelimiter]() {
+							goto l89
+						}
+						if !_rules[ruleBlur]() {
+							goto l89
+						}
+						goto l65
+					l89:
+						position, tokenIndex = position65, tokenIndex65
+						if !_rules[ruleDelimiter]() {
+							goto l90
+						}
+						if !_rules[ruleSharpen]() {
+							goto l90
+						}
+						goto l65
+					l90:
+						position, tokenIndex = position65, tokenIndex65
+						if !_rules[ruleDelimiter]() {
+							goto l91
+						}
+						if !_rules[rulePixelate]() {
+							goto l91
+						}
+						goto l65
+					l91:
+						position, tokenIndex = position65, tokenIndex65
+						if !_rules[ruleDelimiter]() {
+							goto l92
+						}
+						if !_rules[ruleRedact]() {
+							goto l92
+						}
+						goto l65
+					l92:
+						position, tokenIndex = position65, tokenIndex65
+						if !_rules[ruleDelimiter]() {
+							goto l93
+						}
+						if !_rules[ruleRedactMode]() {
+							goto l93
+						}
+						goto l65
+					l93:
+						position, tokenIndex = position65, tokenIndex65
+						if !_rules[ruleDelimiter]() {
+							goto l94
+						}
+						if !_rules[rulePad]() {
+							goto l94
+						}
+						goto l65
+					l94:
+						position, tokenIndex = position65, tokenIndex65
+						if !_rules[ruleDelimiter]() {
+							goto l95
+						}
+						if !_rules[rulePadSides]() {
+							goto l95
+						}
+						goto l65
+					l95:
+						position, tokenIndex = position65, tokenIndex65
+						if !_rules[ruleDelimiter]() {
+							goto l96
+						}
+						if !_rules[ruleBorder]() {
+							goto l96
+						}
+						goto l65
+					l96:
+						position, tokenIndex = position65, tokenIndex65
+						if !_rules[ruleDelimiter]() {
+							goto l97
+						}
+						if !_rules[ruleMarkWidth]() {
+							goto l97
+						}
+						goto l65
+					l97:
+						position, tokenIndex = position65, tokenIndex65
+						if !_rules[ruleDelimiter]() {
+							goto l98
+						}
+						if !_rules[ruleMarkAlign]() {
+							goto l98
+						}
+						goto l65
+					l98:
+						position, tokenIndex = position65, tokenIndex65
+						if !_rules[ruleDelimiter]() {
+							goto l99
+						}
+						if !_rules[ruleMarkPad]() {
+							goto l99
+						}
+						goto l65
+					l99:
+						position, tokenIndex = position65, tokenIndex65
+						if !_rules[ruleDelimiter]() {
+							goto l100
+						}
+						if !_rules[ruleMarkAlpha]() {
+							goto l100
+						}
+						goto l65
+					l100:
+						position, tokenIndex = position65, tokenIndex65
+						if !_rules[ruleDelimiter]() {
+							goto l101
+						}
+						if !_rules[ruleMarkScale]() {
+							goto l101
+						}
+						goto l65
+					l101:
+						position, tokenIndex = position65, tokenIndex65
+						if !_rules[ruleDelimiter]() {
+							goto l102
+						}
+						if !_rules[ruleMark]() {
+							goto l102
+						}
+						goto l65
+					l102:
+						position, tokenIndex = position65, tokenIndex65
+						if !_rules[ruleDelimiter]() {
+							goto l103
+						}
+						if !_rules[ruleTextSize]() {
+							goto l103
+						}
+						goto l65
+					l103:
+						position, tokenIndex = position65, tokenIndex65
+						if !_rules[ruleDelimiter]() {
+							goto l104
+						}
+						if !_rules[ruleTextColor]() {
+							goto l104
+						}
+						goto l65
+					l104:
+						position, tokenIndex = position65, tokenIndex65
+						if !_rules[ruleDelimiter]() {
+							goto l105
+						}
+						if !_rules[ruleTextAlign]() {
+							goto l105
+						}
+						goto l65
+					l105:
+						position, tokenIndex = position65, tokenIndex65
+						if !_rules[ruleDelimiter]() {
+							goto l106
+						}
+						if !_rules[ruleTextPad]() {
+							goto l106
+						}
+						goto l65
+					l106:
+						position, tokenIndex = position65, tokenIndex65
+						if !_rules[ruleDelimiter]() {
+							goto l107
+						}
+						if !_rules[ruleTextFont]() {
+							goto l107
+						}
+						goto l65
+					l107:
+						position, tokenIndex = position65, tokenIndex65
+						if !_rules[ruleDelimiter]() {
+							goto l108
+						}
+						if !_rules[ruleText]() {
+							goto l108
+						}
+						goto l65
+					l108:
+						position, tokenIndex = position65, tokenIndex65
+						if !_rules[ruleDelimiter]() {
+							goto l109
+						}
+						if !_rules[ruleCornerRadius]() {
+							goto l109
+						}
+						goto l65
+					l109:
+						position, tokenIndex = position65, tokenIndex65
+						if !_rules[ruleDelimiter]() {
+							goto l110
+						}
+						if !_rules[ruleMask]() {
+							goto l110
+						}
+						goto l65
+					l110:
+						position, tokenIndex = position65, tokenIndex65
+						if !_rules[ruleDelimiter]() {
+							goto l111
+						}
+						if !_rules[ruleTrim]() {
+							goto l111
+						}
+						goto l65
+					l111:
+						position, tokenIndex = position65, tokenIndex65
+						if !_rules[ruleDelimiter]() {
+							goto l112
+						}
+						if !_rules[ruleTrimTol]() {
+							goto l112
+						}
+						goto l65
+					l112:
+						position, tokenIndex = position65, tokenIndex65
+						if !_rules[ruleDelimiter]() {
+							goto l113
+						}
+						if !_rules[ruleTrimColor]() {
+							goto l113
+						}
+						goto l65
+					l113:
+						position, tokenIndex = position65, tokenIndex65
+						if !_rules[ruleDelimiter]() {
+							goto l114
+						}
+						if !_rules[ruleFilter]() {
+							goto l114
+						}
+						goto l65
+					l114:
+						position, tokenIndex = position65, tokenIndex65
+						if !_rules[ruleDelimiter]() {
+							goto l115
+						}
+						if !_rules[ruleUpscale]() {
+							goto l115
+						}
+						goto l65
+					l115:
+						position, tokenIndex = position65, tokenIndex65
+						if !_rules[ruleDelimiter]() {
+							goto l116
+						}
+						if !_rules[rulePalette]() {
+							goto l116
+						}
+						goto l65
+					l116:
+						position, tokenIndex = position65, tokenIndex65
+						if !_rules[ruleDelimiter]() {
+							goto l117
+						}
+						if !_rules[ruleColors]() {
+							goto l117
+						}
+						goto l65
+					l117:
+						position, tokenIndex = position65, tokenIndex65
+						if !_rules[ruleDelimiter]() {
+							goto l118
+						}
+						if !_rules[ruleLqip]() {
+							goto l118
+						}
+						goto l65
+					l118:
+						position, tokenIndex = position65, tokenIndex65
+						if !_rules[ruleDelimiter]() {
+							goto l119
+						}
+						if !_rules[rulePHash]() {
+							goto l119
+						}
+						goto l65
+					l119:
+						position, tokenIndex = position65, tokenIndex65
+						if !_rules[ruleDelimiter]() {
+							goto l120
+						}
+						if !_rules[ruleFrame]() {
+							goto l120
+						}
+						goto l65
+					l120:
+						position, tokenIndex = position65, tokenIndex65
+						if !_rules[ruleDelimiter]() {
+							goto l121
+						}
+						if !_rules[ruleMaxBytes]() {
+							goto l121
+						}
+						goto l65
+					l121:
+						position, tokenIndex = position65, tokenIndex65
+						if !_rules[ruleDelimiter]() {
+							goto l122
+						}
+						if !_rules[ruleLossless]() {
+							goto l122
+						}
+						goto l65
+					l122:
+						position, tokenIndex = position65, tokenIndex65
+						if !_rules[ruleDelimiter]() {
+							goto l123
+						}
+						if !_rules[ruleDither]() {
+							goto l123
+						}
+						goto l65
+					l123:
+						position, tokenIndex = position65, tokenIndex65
+						if !_rules[ruleDelimiter]() {
+							goto l124
+						}
+						if !_rules[ruleSignSeparator]() {
+							goto l124
+						}
+						goto l65
+					l124:
+						position, tokenIndex = position65, tokenIndex65
+						if !_rules[ruleDelimiter]() {
+							goto l125
+						}
+						if !_rules[ruleSkipParam]() {
+							goto l125
+						}
+						goto l65
+					l125:
+						position, tokenIndex = position65, tokenIndex65
+						if !_rules[ruleDelimiter]() {
+							goto l3
+						}
+					}
+				l65:
+					goto l2
+				l3:
+					position, tokenIndex = position3, tokenIndex3
+				}
+				if !_rules[ruleEOF]() {
+					goto l0
+				}
+				add(ruleExpression, position1)
+			}
+			return true
+		l0:
+			position, tokenIndex = position0, tokenIndex0
+			return false
+		},
+		/* 1 Format <- <(Format_Key Separater <LowerCase> (&And / EOF) Action0)> */
+		func() bool {
+			position126, tokenIndex126 := position, tokenIndex
+			{
+				position127 := position
+				if !_rules[ruleFormat_Key]() {
+					goto l126
+				}
+				if !_rules[ruleSeparater]() {
+					goto l126
+				}
+				{
+					position128 := position
+					if !_rules[ruleLowerCase]() {
+						goto l126
+					}
+					add(rulePegText, position128)
+				}
+				{
+					position129, tokenIndex129 := position, tokenIndex
+					{
+						position131, tokenIndex131 := position, tokenIndex
+						if !_rules[ruleAnd]() {
+							goto l130
+						}
+						position, tokenIndex = position131, tokenIndex131
+					}
+					goto l129
+				l130:
+					position, tokenIndex = position129, tokenIndex129
+					if !_rules[ruleEOF]() {
+						goto l126
+					}
+				}
+			l129:
+				if !_rules[ruleAction0]() {
+					goto l126
+				}
+				add(ruleFormat, position127)
+			}
+			return true
+		l126:
+			position, tokenIndex = position126, tokenIndex126
+			return false
+		},
+		/* 2 Progressive <- <(Progressive_Key Separater <Bool> (&And / EOF) Action1)> */
+		func() bool {
+			position132, tokenIndex132 := position, tokenIndex
+			{
+				position133 := position
+				if !_rules[ruleProgressive_Key]() {
+					goto l132
+				}
+				if !_rules[ruleSeparater]() {
+					goto l132
+				}
+				{
+					position134 := position
+					if !_rules[ruleBool]() {
+						goto l132
+					}
+					add(rulePegText, position134)
+				}
+				{
+					position135, tokenIndex135 := position, tokenIndex
+					{
+						position137, tokenIndex137 := position, tokenIndex
+						if !_rules[ruleAnd]() {
+							goto l136
+						}
+						position, tokenIndex = position137, tokenIndex137
+					}
+					goto l135
+				l136:
+					position, tokenIndex = position135, tokenIndex135
+					if !_rules[ruleEOF]() {
+						goto l132
+					}
+				}
+			l135:
+				if !_rules[ruleAction1]() {
+					goto l132
+				}
+				add(ruleProgressive, position133)
+			}
+			return true
+		l132:
+			position, tokenIndex = position132, tokenIndex132
+			return false
+		},
+		/* 3 Width <- <(Width_Key Separater <(Digit / Dot)+> (&And / EOF) Action2)> */
+		func() bool {
+			position138, tokenIndex138 := position, tokenIndex
+			{
+				position139 := position
+				if !_rules[ruleWidth_Key]() {
+					goto l138
+				}
+				if !_rules[ruleSeparater]() {
+					goto l138
+				}
+				{
+					position140 := position
+					{
+						position143, tokenIndex143 := position, tokenIndex
+						if !_rules[ruleDigit]() {
+							goto l144
+						}
+						goto l143
+					l144:
+						position, tokenIndex = position143, tokenIndex143
+						if !_rules[ruleDot]() {
+							goto l138
+						}
+					}
+				l143:
+				l141:
+					{
+						position142, tokenIndex142 := position, tokenIndex
+						{
+							position145, tokenIndex145 := position, tokenIndex
+							if !_rules[ruleDigit]() {
+								goto l146
+							}
+							goto l145
+						l146:
+							position, tokenIndex = position145, tokenIndex145
+							if !_rules[ruleDot]() {
+								goto l142
+							}
+						}
+					l145:
+						goto l141
+					l142:
+						position, tokenIndex = position142, tokenIndex142
+					}
+					add(rulePegText, position140)
+				}
+				{
+					position147, tokenIndex147 := position, tokenIndex
+					{
+						position149, tokenIndex149 := position, tokenIndex
+						if !_rules[ruleAnd]() {
+							goto l148
+						}
+						position, tokenIndex = position149, tokenIndex149
+					}
+					goto l147
+				l148:
+					position, tokenIndex = position147, tokenIndex147
+					if !_rules[ruleEOF]() {
+						goto l138
+					}
+				}
+			l147:
+				if !_rules[ruleAction2]() {
+					goto l138
+				}
+				add(ruleWidth, position139)
+			}
+			return true
+		l138:
+			position, tokenIndex = position138, tokenIndex138
+			return false
+		},
+		/* 4 Height <- <(Height_Key Separater <(Digit / Dot)+> (&And / EOF) Action3)> */
+		func() bool {
+			position150, tokenIndex150 := position, tokenIndex
+			{
+				position151 := position
+				if !_rules[ruleHeight_Key]() {
+					goto l150
+				}
+				if !_rules[ruleSeparater]() {
+					goto l150
+				}
+				{
+					position152 := position
+					{
+						position155, tokenIndex155 := position, tokenIndex
+						if !_rules[ruleDigit]() {
+							goto l156
+						}
+						goto l155
+					l156:
+						position, tokenIndex = position155, tokenIndex155
+						if !_rules[ruleDot]() {
+							goto l150
+						}
+					}
+				l155:
+				l153:
+					{
+						position154, tokenIndex154 := position, tokenIndex
+						{
+							position157, tokenIndex157 := position, tokenIndex
+							if !_rules[ruleDigit]() {
+								goto l158
+							}
+							goto l157
+						l158:
+							position, tokenIndex = position157, tokenIndex157
+							if !_rules[ruleDot]() {
+								goto l154
+							}
+						}
+					l157:
+						goto l153
+					l154:
+						position, tokenIndex = position154, tokenIndex154
+					}
+					add(rulePegText, position152)
+				}
+				{
+					position159, tokenIndex159 := position, tokenIndex
+					{
+						position161, tokenIndex161 := position, tokenIndex
+						if !_rules[ruleAnd]() {
+							goto l160
+						}
+						position, tokenIndex = position161, tokenIndex161
+					}
+					goto l159
+				l160:
+					position, tokenIndex = position159, tokenIndex159
+					if !_rules[ruleEOF]() {
+						goto l150
+					}
+				}
+			l159:
+				if !_rules[ruleAction3]() {
+					goto l150
+				}
+				add(ruleHeight, position151)
+			}
+			return true
+		l150:
+			position, tokenIndex = position150, tokenIndex150
+			return false
+		},
+		/* 5 Fit <- <(Fit_Key Separater <FitParam> (&And / EOF) Action4)> */
+		func() bool {
+			position162, tokenIndex162 := position, tokenIndex
+			{
+				position163 := position
+				if !_rules[ruleFit_Key]() {
+					goto l162
+				}
+				if !_rules[ruleSeparater]() {
+					goto l162
+				}
+				{
+					position164 := position
+					if !_rules[ruleFitParam]() {
+						goto l162
+					}
+					add(rulePegText, position164)
+				}
+				{
+					position165, tokenIndex165 := position, tokenIndex
+					{
+						position167, tokenIndex167 := position, tokenIndex
+						if !_rules[ruleAnd]() {
+							goto l166
+						}
+						position, tokenIndex = position167, tokenIndex167
+					}
+					goto l165
+				l166:
+					position, tokenIndex = position165, tokenIndex165
+					if !_rules[ruleEOF]() {
+						goto l162
+					}
+				}
+			l165:
 				if !_rules[ruleAction4]() {
-					goto l64
+					goto l162
+				}
+				add(ruleFit, position163)
+			}
+			return true
+		l162:
+			position, tokenIndex = position162, tokenIndex162
+			return false
+		},
+		/* 6 Scale <- <(Scale_Key Separater <(Digit / Dot)+> (&And / EOF) Action5)> */
+		func() bool {
+			position168, tokenIndex168 := position, tokenIndex
+			{
+				position169 := position
+				if !_rules[ruleScale_Key]() {
+					goto l168
+				}
+				if !_rules[ruleSeparater]() {
+					goto l168
+				}
+				{
+					position170 := position
+					{
+						position173, tokenIndex173 := position, tokenIndex
+						if !_rules[ruleDigit]() {
+							goto l174
+						}
+						goto l173
+					l174:
+						position, tokenIndex = position173, tokenIndex173
+						if !_rules[ruleDot]() {
+							goto l168
+						}
+					}
+				l173:
+				l171:
+					{
+						position172, tokenIndex172 := position, tokenIndex
+						{
+							position175, tokenIndex175 := position, tokenIndex
+							if !_rules[ruleDigit]() {
+								goto l176
+							}
+							goto l175
+						l176:
+							position, tokenIndex = position175, tokenIndex175
+							if !_rules[ruleDot]() {
+								goto l172
+							}
+						}
+					l175:
+						goto l171
+					l172:
+						position, tokenIndex = position172, tokenIndex172
+					}
+					add(rulePegText, position170)
+				}
+				{
+					position177, tokenIndex177 := position, tokenIndex
+					{
+						position179, tokenIndex179 := position, tokenIndex
+						if !_rules[ruleAnd]() {
+							goto l178
+						}
+						position, tokenIndex = position179, tokenIndex179
+					}
+					goto l177
+				l178:
+					position, tokenIndex = position177, tokenIndex177
+					if !_rules[ruleEOF]() {
+						goto l168
+					}
+				}
+			l177:
+				if !_rules[ruleAction5]() {
+					goto l168
+				}
+				add(ruleScale, position169)
+			}
+			return true
+		l168:
+			position, tokenIndex = position168, tokenIndex168
+			return false
+		},
+		/* 7 Reverse <- <(Reverse_Key Separater <ReverseParam> (&And / EOF) Action6)> */
+		func() bool {
+			position180, tokenIndex180 := position, tokenIndex
+			{
+				position181 := position
+				if !_rules[ruleReverse_Key]() {
+					goto l180
+				}
+				if !_rules[ruleSeparater]() {
+					goto l180
+				}
+				{
+					position182 := position
+					if !_rules[ruleReverseParam]() {
+						goto l180
+					}
+					add(rulePegText, position182)
+				}
+				{
+					position183, tokenIndex183 := position, tokenIndex
+					{
+						position185, tokenIndex185 := position, tokenIndex
+						if !_rules[ruleAnd]() {
+							goto l184
+						}
+						position, tokenIndex = position185, tokenIndex185
+					}
+					goto l183
+				l184:
+					position, tokenIndex = position183, tokenIndex183
+					if !_rules[ruleEOF]() {
+						goto l180
+					}
+				}
+			l183:
+				if !_rules[ruleAction6]() {
+					goto l180
+				}
+				add(ruleReverse, position181)
+			}
+			return true
+		l180:
+			position, tokenIndex = position180, tokenIndex180
+			return false
+		},
+		/* 8 Crop <- <(Crop_Key Tuple_P (&And / EOF) Action7)> */
+		func() bool {
+			position186, tokenIndex186 := position, tokenIndex
+			{
+				position187 := position
+				if !_rules[ruleCrop_Key]() {
+					goto l186
+				}
+				if !_rules[ruleTuple_P]() {
+					goto l186
+				}
+				{
+					position188, tokenIndex188 := position, tokenIndex
+					{
+						position190, tokenIndex190 := position, tokenIndex
+						if !_rules[ruleAnd]() {
+							goto l189
+						}
+						position, tokenIndex = position190, tokenIndex190
+					}
+					goto l188
+				l189:
+					position, tokenIndex = position188, tokenIndex188
+					if !_rules[ruleEOF]() {
+						goto l186
+					}
+				}
+			l188:
+				if !_rules[ruleAction7]() {
+					goto l186
+				}
+				add(ruleCrop, position187)
+			}
+			return true
+		l186:
+			position, tokenIndex = position186, tokenIndex186
+			return false
+		},
+		/* 9 Quality <- <(Quality_Key Separater <(Digit / Dot)+> (&And / EOF) Action8)> */
+		func() bool {
+			position191, tokenIndex191 := position, tokenIndex
+			{
+				position192 := position
+				if !_rules[ruleQuality_Key]() {
+					goto l191
+				}
+				if !_rules[ruleSeparater]() {
+					goto l191
+				}
+				{
+					position193 := position
+					{
+						position196, tokenIndex196 := position, tokenIndex
+						if !_rules[ruleDigit]() {
+							goto l197
+						}
+						goto l196
+					l197:
+						position, tokenIndex = position196, tokenIndex196
+						if !_rules[ruleDot]() {
+							goto l191
+						}
+					}
+				l196:
+				l194:
+					{
+						position195, tokenIndex195 := position, tokenIndex
+						{
+							position198, tokenIndex198 := position, tokenIndex
+							if !_rules[ruleDigit]() {
+								goto l199
+							}
+							goto l198
+						l199:
+							position, tokenIndex = position198, tokenIndex198
+							if !_rules[ruleDot]() {
+								goto l195
+							}
+						}
+					l198:
+						goto l194
+					l195:
+						position, tokenIndex = position195, tokenIndex195
+					}
+					add(rulePegText, position193)
+				}
+				{
+					position200, tokenIndex200 := position, tokenIndex
+					{
+						position202, tokenIndex202 := position, tokenIndex
+						if !_rules[ruleAnd]() {
+							goto l201
+						}
+						position, tokenIndex = position202, tokenIndex202
+					}
+					goto l200
+				l201:
+					position, tokenIndex = position200, tokenIndex200
+					if !_rules[ruleEOF]() {
+						goto l191
+					}
+				}
+			l200:
+				if !_rules[ruleAction8]() {
+					goto l191
+				}
+				add(ruleQuality, position192)
+			}
+			return true
+		l191:
+			position, tokenIndex = position191, tokenIndex191
+			return false
+		},
+		/* 10 Exif <- <(Exif_Key Separater <Bool> (&And / EOF) Action9)> */
+		func() bool {
+			position203, tokenIndex203 := position, tokenIndex
+			{
+				position204 := position
+				if !_rules[ruleExif_Key]() {
+					goto l203
+				}
+				if !_rules[ruleSeparater]() {
+					goto l203
+				}
+				{
+					position205 := position
+					if !_rules[ruleBool]() {
+						goto l203
+					}
+					add(rulePegText, position205)
+				}
+				{
+					position206, tokenIndex206 := position, tokenIndex
+					{
+						position208, tokenIndex208 := position, tokenIndex
+						if !_rules[ruleAnd]() {
+							goto l207
+						}
+						position, tokenIndex = position208, tokenIndex208
+					}
+					goto l206
+				l207:
+					position, tokenIndex = position206, tokenIndex206
+					if !_rules[ruleEOF]() {
+						goto l203
+					}
+				}
+			l206:
+				if !_rules[ruleAction9]() {
+					goto l203
+				}
+				add(ruleExif, position204)
+			}
+			return true
+		l203:
+			position, tokenIndex = position203, tokenIndex203
+			return false
+		},
+		/* 11 StripGps <- <(StripGps_Key Separater <Bool> (&And / EOF) Action10)> */
+		func() bool {
+			position209, tokenIndex209 := position, tokenIndex
+			{
+				position210 := position
+				if !_rules[ruleStripGps_Key]() {
+					goto l209
+				}
+				if !_rules[ruleSeparater]() {
+					goto l209
+				}
+				{
+					position211 := position
+					if !_rules[ruleBool]() {
+						goto l209
+					}
+					add(rulePegText, position211)
+				}
+				{
+					position212, tokenIndex212 := position, tokenIndex
+					{
+						position214, tokenIndex214 := position, tokenIndex
+						if !_rules[ruleAnd]() {
+							goto l213
+						}
+						position, tokenIndex = position214, tokenIndex214
+					}
+					goto l212
+				l213:
+					position, tokenIndex = position212, tokenIndex212
+					if !_rules[ruleEOF]() {
+						goto l209
+					}
+				}
+			l212:
+				if !_rules[ruleAction10]() {
+					goto l209
+				}
+				add(ruleStripGps, position210)
+			}
+			return true
+		l209:
+			position, tokenIndex = position209, tokenIndex209
+			return false
+		},
+		/* 12 AspectRatio <- <(AspectRatio_Key Separater <(Digit ((Colon / Dot) Digit)?)> (&And / EOF) Action11)> */
+		func() bool {
+			position215, tokenIndex215 := position, tokenIndex
+			{
+				position216 := position
+				if !_rules[ruleAspectRatio_Key]() {
+					goto l215
+				}
+				if !_rules[ruleSeparater]() {
+					goto l215
+				}
+				{
+					position217 := position
+					if !_rules[ruleDigit]() {
+						goto l215
+					}
+					{
+						position218, tokenIndex218 := position, tokenIndex
+						{
+							position220, tokenIndex220 := position, tokenIndex
+							if !_rules[ruleColon]() {
+								goto l221
+							}
+							goto l220
+						l221:
+							position, tokenIndex = position220, tokenIndex220
+							if !_rules[ruleDot]() {
+								goto l218
+							}
+						}
+					l220:
+						if !_rules[ruleDigit]() {
+							goto l218
+						}
+						goto l219
+					l218:
+						position, tokenIndex = position218, tokenIndex218
+					}
+				l219:
+					add(rulePegText, position217)
+				}
+				{
+					position222, tokenIndex222 := position, tokenIndex
+					{
+						position224, tokenIndex224 := position, tokenIndex
+						if !_rules[ruleAnd]() {
+							goto l223
+						}
+						position, tokenIndex = position224, tokenIndex224
+					}
+					goto l222
+				l223:
+					position, tokenIndex = position222, tokenIndex222
+					if !_rules[ruleEOF]() {
+						goto l215
+					}
+				}
+			l222:
+				if !_rules[ruleAction11]() {
+					goto l215
+				}
+				add(ruleAspectRatio, position216)
+			}
+			return true
+		l215:
+			position, tokenIndex = position215, tokenIndex215
+			return false
+		},
+		/* 13 Background <- <(Background_Key Separater <HexColor> (&And / EOF) Action12)> */
+		func() bool {
+			position225, tokenIndex225 := position, tokenIndex
+			{
+				position226 := position
+				if !_rules[ruleBackground_Key]() {
+					goto l225
+				}
+				if !_rules[ruleSeparater]() {
+					goto l225
+				}
+				{
+					position227 := position
+					if !_rules[ruleHexColor]() {
+						goto l225
+					}
+					add(rulePegText, position227)
+				}
+				{
+					position228, tokenIndex228 := position, tokenIndex
+					{
+						position230, tokenIndex230 := position, tokenIndex
+						if !_rules[ruleAnd]() {
+							goto l229
+						}
+						position, tokenIndex = position230, tokenIndex230
+					}
+					goto l228
+				l229:
+					position, tokenIndex = position228, tokenIndex228
+					if !_rules[ruleEOF]() {
+						goto l225
+					}
+				}
+			l228:
+				if !_rules[ruleAction12]() {
+					goto l225
+				}
+				add(ruleBackground, position226)
+			}
+			return true
+		l225:
+			position, tokenIndex = position225, tokenIndex225
+			return false
+		},
+		/* 14 Dpr <- <(Dpr_Key Separater <(Digit / Dot)+> (&And / EOF) Action13)> */
+		func() bool {
+			position231, tokenIndex231 := position, tokenIndex
+			{
+				position232 := position
+				if !_rules[ruleDpr_Key]() {
+					goto l231
+				}
+				if !_rules[ruleSeparater]() {
+					goto l231
+				}
+				{
+					position233 := position
+					{
+						position236, tokenIndex236 := position, tokenIndex
+						if !_rules[ruleDigit]() {
+							goto l237
+						}
+						goto l236
+					l237:
+						position, tokenIndex = position236, tokenIndex236
+						if !_rules[ruleDot]() {
+							goto l231
+						}
+					}
+				l236:
+				l234:
+					{
+						position235, tokenIndex235 := position, tokenIndex
+						{
+							position238, tokenIndex238 := position, tokenIndex
+							if !_rules[ruleDigit]() {
+								goto l239
+							}
+							goto l238
+						l239:
+							position, tokenIndex = position238, tokenIndex238
+							if !_rules[ruleDot]() {
+								goto l235
+							}
+						}
+					l238:
+						goto l234
+					l235:
+						position, tokenIndex = position235, tokenIndex235
+					}
+					add(rulePegText, position233)
+				}
+				{
+					position240, tokenIndex240 := position, tokenIndex
+					{
+						position242, tokenIndex242 := position, tokenIndex
+						if !_rules[ruleAnd]() {
+							goto l241
+						}
+						position, tokenIndex = position242, tokenIndex242
+					}
+					goto l240
+				l241:
+					position, tokenIndex = position240, tokenIndex240
+					if !_rules[ruleEOF]() {
+						goto l231
+					}
+				}
+			l240:
+				if !_rules[ruleAction13]() {
+					goto l231
+				}
+				add(ruleDpr, position232)
+			}
+			return true
+		l231:
+			position, tokenIndex = position231, tokenIndex231
+			return false
+		},
+		/* 15 Brightness <- <(Brightness_Key Equal <Signed> (&And / EOF) Action14)> */
+		func() bool {
+			position243, tokenIndex243 := position, tokenIndex
+			{
+				position244 := position
+				if !_rules[ruleBrightness_Key]() {
+					goto l243
+				}
+				if !_rules[ruleEqual]() {
+					goto l243
+				}
+				{
+					position245 := position
+					if !_rules[ruleSigned]() {
+						goto l243
+					}
+					add(rulePegText, position245)
+				}
+				{
+					position246, tokenIndex246 := position, tokenIndex
+					{
+						position248, tokenIndex248 := position, tokenIndex
+						if !_rules[ruleAnd]() {
+							goto l247
+						}
+						position, tokenIndex = position248, tokenIndex248
+					}
+					goto l246
+				l247:
+					position, tokenIndex = position246, tokenIndex246
+					if !_rules[ruleEOF]() {
+						goto l243
+					}
+				}
+			l246:
+				if !_rules[ruleAction14]() {
+					goto l243
+				}
+				add(ruleBrightness, position244)
+			}
+			return true
+		l243:
+			position, tokenIndex = position243, tokenIndex243
+			return false
+		},
+		/* 16 Contrast <- <(Contrast_Key Equal <Signed> (&And / EOF) Action15)> */
+		func() bool {
+			position249, tokenIndex249 := position, tokenIndex
+			{
+				position250 := position
+				if !_rules[ruleContrast_Key]() {
+					goto l249
+				}
+				if !_rules[ruleEqual]() {
+					goto l249
+				}
+				{
+					position251 := position
+					if !_rules[ruleSigned]() {
+						goto l249
+					}
+					add(rulePegText, position251)
+				}
+				{
+					position252, tokenIndex252 := position, tokenIndex
+					{
+						position254, tokenIndex254 := position, tokenIndex
+						if !_rules[ruleAnd]() {
+							goto l253
+						}
+						position, tokenIndex = position254, tokenIndex254
+					}
+					goto l252
+				l253:
+					position, tokenIndex = position252, tokenIndex252
+					if !_rules[ruleEOF]() {
+						goto l249
+					}
+				}
+			l252:
+				if !_rules[ruleAction15]() {
+					goto l249
+				}
+				add(ruleContrast, position250)
+			}
+			return true
+		l249:
+			position, tokenIndex = position249, tokenIndex249
+			return false
+		},
+		/* 17 Saturation <- <(Saturation_Key Equal <Signed> (&And / EOF) Action16)> */
+		func() bool {
+			position255, tokenIndex255 := position, tokenIndex
+			{
+				position256 := position
+				if !_rules[ruleSaturation_Key]() {
+					goto l255
+				}
+				if !_rules[ruleEqual]() {
+					goto l255
+				}
+				{
+					position257 := position
+					if !_rules[ruleSigned]() {
+						goto l255
+					}
+					add(rulePegText, position257)
+				}
+				{
+					position258, tokenIndex258 := position, tokenIndex
+					{
+						position260, tokenIndex260 := position, tokenIndex
+						if !_rules[ruleAnd]() {
+							goto l259
+						}
+						position, tokenIndex = position260, tokenIndex260
+					}
+					goto l258
+				l259:
+					position, tokenIndex = position258, tokenIndex258
+					if !_rules[ruleEOF]() {
+						goto l255
+					}
+				}
+			l258:
+				if !_rules[ruleAction16]() {
+					goto l255
+				}
+				add(ruleSaturation, position256)
+			}
+			return true
+		l255:
+			position, tokenIndex = position255, tokenIndex255
+			return false
+		},
+		/* 18 Gamma <- <(Gamma_Key Equal <Signed> (&And / EOF) Action17)> */
+		func() bool {
+			position261, tokenIndex261 := position, tokenIndex
+			{
+				position262 := position
+				if !_rules[ruleGamma_Key]() {
+					goto l261
+				}
+				if !_rules[ruleEqual]() {
+					goto l261
+				}
+				{
+					position263 := position
+					if !_rules[ruleSigned]() {
+						goto l261
+					}
+					add(rulePegText, position263)
+				}
+				{
+					position264, tokenIndex264 := position, tokenIndex
+					{
+						position266, tokenIndex266 := position, tokenIndex
+						if !_rules[ruleAnd]() {
+							goto l265
+						}
+						position, tokenIndex = position266, tokenIndex266
+					}
+					goto l264
+				l265:
+					position, tokenIndex = position264, tokenIndex264
+					if !_rules[ruleEOF]() {
+						goto l261
+					}
+				}
+			l264:
+				if !_rules[ruleAction17]() {
+					goto l261
+				}
+				add(ruleGamma, position262)
+			}
+			return true
+		l261:
+			position, tokenIndex = position261, tokenIndex261
+			return false
+		},
+		/* 19 Hue <- <(Hue_Key Equal <Signed> (&And / EOF) Action18)> */
+		func() bool {
+			position267, tokenIndex267 := position, tokenIndex
+			{
+				position268 := position
+				if !_rules[ruleHue_Key]() {
+					goto l267
+				}
+				if !_rules[ruleEqual]() {
+					goto l267
+				}
+				{
+					position269 := position
+					if !_rules[ruleSigned]() {
+						goto l267
+					}
+					add(rulePegText, position269)
+				}
+				{
+					position270, tokenIndex270 := position, tokenIndex
+					{
+						position272, tokenIndex272 := position, tokenIndex
+						if !_rules[ruleAnd]() {
+							goto l271
+						}
+						position, tokenIndex = position272, tokenIndex272
+					}
+					goto l270
+				l271:
+					position, tokenIndex = position270, tokenIndex270
+					if !_rules[ruleEOF]() {
+						goto l267
+					}
+				}
+			l270:
+				if !_rules[ruleAction18]() {
+					goto l267
+				}
+				add(ruleHue, position268)
+			}
+			return true
+		l267:
+			position, tokenIndex = position267, tokenIndex267
+			return false
+		},
+		/* 20 Mono <- <(Mono_Key Separater <Bool> (&And / EOF) Action19)> */
+		func() bool {
+			position273, tokenIndex273 := position, tokenIndex
+			{
+				position274 := position
+				if !_rules[ruleMono_Key]() {
+					goto l273
+				}
+				if !_rules[ruleSeparater]() {
+					goto l273
+				}
+				{
+					position275 := position
+					if !_rules[ruleBool]() {
+						goto l273
+					}
+					add(rulePegText, position275)
+				}
+				{
+					position276, tokenIndex276 := position, tokenIndex
+					{
+						position278, tokenIndex278 := position, tokenIndex
+						if !_rules[ruleAnd]() {
+							goto l277
+						}
+						position, tokenIndex = position278, tokenIndex278
+					}
+					goto l276
+				l277:
+					position, tokenIndex = position276, tokenIndex276
+					if !_rules[ruleEOF]() {
+						goto l273
+					}
+				}
+			l276:
+				if !_rules[ruleAction19]() {
+					goto l273
+				}
+				add(ruleMono, position274)
+			}
+			return true
+		l273:
+			position, tokenIndex = position273, tokenIndex273
+			return false
+		},
+		/* 21 Sepia <- <(Sepia_Key Separater <(Digit / Dot)+> (&And / EOF) Action20)> */
+		func() bool {
+			position279, tokenIndex279 := position, tokenIndex
+			{
+				position280 := position
+				if !_rules[ruleSepia_Key]() {
+					goto l279
+				}
+				if !_rules[ruleSeparater]() {
+					goto l279
+				}
+				{
+					position281 := position
+					{
+						position284, tokenIndex284 := position, tokenIndex
+						if !_rules[ruleDigit]() {
+							goto l285
+						}
+						goto l284
+					l285:
+						position, tokenIndex = position284, tokenIndex284
+						if !_rules[ruleDot]() {
+							goto l279
+						}
+					}
+				l284:
+				l282:
+					{
+						position283, tokenIndex283 := position, tokenIndex
+						{
+							position286, tokenIndex286 := position, tokenIndex
+							if !_rules[ruleDigit]() {
+								goto l287
+							}
+							goto l286
+						l287:
+							position, tokenIndex = position286, tokenIndex286
+							if !_rules[ruleDot]() {
+								goto l283
+							}
+						}
+					l286:
+						goto l282
+					l283:
+						position, tokenIndex = position283, tokenIndex283
+					}
+					add(rulePegText, position281)
+				}
+				{
+					position288, tokenIndex288 := position, tokenIndex
+					{
+						position290, tokenIndex290 := position, tokenIndex
+						if !_rules[ruleAnd]() {
+							goto l289
+						}
+						position, tokenIndex = position290, tokenIndex290
+					}
+					goto l288
+				l289:
+					position, tokenIndex = position288, tokenIndex288
+					if !_rules[ruleEOF]() {
+						goto l279
+					}
+				}
+			l288:
+				if !_rules[ruleAction20]() {
+					goto l279
+				}
+				add(ruleSepia, position280)
+			}
+			return true
+		l279:
+			position, tokenIndex = position279, tokenIndex279
+			return false
+		},
+		/* 22 Invert <- <(Invert_Key Separater <Bool> (&And / EOF) Action21)> */
+		func() bool {
+			position291, tokenIndex291 := position, tokenIndex
+			{
+				position292 := position
+				if !_rules[ruleInvert_Key]() {
+					goto l291
+				}
+				if !_rules[ruleSeparater]() {
+					goto l291
+				}
+				{
+					position293 := position
+					if !_rules[ruleBool]() {
+						goto l291
+					}
+					add(rulePegText, position293)
+				}
+				{
+					position294, tokenIndex294 := position, tokenIndex
+					{
+						position296, tokenIndex296 := position, tokenIndex
+						if !_rules[ruleAnd]() {
+							goto l295
+						}
+						position, tokenIndex = position296, tokenIndex296
+					}
+					goto l294
+				l295:
+					position, tokenIndex = position294, tokenIndex294
+					if !_rules[ruleEOF]() {
+						goto l291
+					}
+				}
+			l294:
+				if !_rules[ruleAction21]() {
+					goto l291
+				}
+				add(ruleInvert, position292)
+			}
+			return true
+		l291:
+			position, tokenIndex = position291, tokenIndex291
+			return false
+		},
+		/* 23 Duotone <- <(Duotone_Key Separater <(HexColor Comma HexColor)> (&And / EOF) Action22)> */
+		func() bool {
+			position297, tokenIndex297 := position, tokenIndex
+			{
+				position298 := position
+				if !_rules[ruleDuotone_Key]() {
+					goto l297
+				}
+				if !_rules[ruleSeparater]() {
+					goto l297
+				}
+				{
+					position299 := position
+					if !_rules[ruleHexColor]() {
+						goto l297
+					}
+					if !_rules[ruleComma]() {
+						goto l297
+					}
+					if !_rules[ruleHexColor]() {
+						goto l297
+					}
+					add(rulePegText, position299)
+				}
+				{
+					position300, tokenIndex300 := position, tokenIndex
+					{
+						position302, tokenIndex302 := position, tokenIndex
+						if !_rules[ruleAnd]() {
+							goto l301
+						}
+						position, tokenIndex = position302, tokenIndex302
+					}
+					goto l300
+				l301:
+					position, tokenIndex = position300, tokenIndex300
+					if !_rules[ruleEOF]() {
+						goto l297
+					}
+				}
+			l300:
+				if !_rules[ruleAction22]() {
+					goto l297
+				}
+				add(ruleDuotone, position298)
+			}
+			return true
+		l297:
+			position, tokenIndex = position297, tokenIndex297
+			return false
+		},
+		/* 24 Blur <- <(Blur_Key Separater <(Digit / Dot)+> (&And / EOF) Action23)> */
+		func() bool {
+			position303, tokenIndex303 := position, tokenIndex
+			{
+				position304 := position
+				if !_rules[ruleBlur_Key]() {
+					goto l303
+				}
+				if !_rules[ruleSeparater]() {
+					goto l303
+				}
+				{
+					position305 := position
+					{
+						position308, tokenIndex308 := position, tokenIndex
+						if !_rules[ruleDigit]() {
+							goto l309
+						}
+						goto l308
+					l309:
+						position, tokenIndex = position308, tokenIndex308
+						if !_rules[ruleDot]() {
+							goto l303
+						}
+					}
+				l308:
+				l306:
+					{
+						position307, tokenIndex307 := position, tokenIndex
+						{
+							position310, tokenIndex310 := position, tokenIndex
+							if !_rules[ruleDigit]() {
+								goto l311
+							}
+							goto l310
+						l311:
+							position, tokenIndex = position310, tokenIndex310
+							if !_rules[ruleDot]() {
+								goto l307
+							}
+						}
+					l310:
+						goto l306
+					l307:
+						position, tokenIndex = position307, tokenIndex307
+					}
+					add(rulePegText, position305)
+				}
+				{
+					position312, tokenIndex312 := position, tokenIndex
+					{
+						position314, tokenIndex314 := position, tokenIndex
+						if !_rules[ruleAnd]() {
+							goto l313
+						}
+						position, tokenIndex = position314, tokenIndex314
+					}
+					goto l312
+				l313:
+					position, tokenIndex = position312, tokenIndex312
+					if !_rules[ruleEOF]() {
+						goto l303
+					}
+				}
+			l312:
+				if !_rules[ruleAction23]() {
+					goto l303
+				}
+				add(ruleBlur, position304)
+			}
+			return true
+		l303:
+			position, tokenIndex = position303, tokenIndex303
+			return false
+		},
+		/* 25 Sharpen <- <(Sharpen_Key Separater <(Digit / Dot)+> (&And / EOF) Action24)> */
+		func() bool {
+			position315, tokenIndex315 := position, tokenIndex
+			{
+				position316 := position
+				if !_rules[ruleSharpen_Key]() {
+					goto l315
+				}
+				if !_rules[ruleSeparater]() {
+					goto l315
+				}
+				{
+					position317 := position
+					{
+						position320, tokenIndex320 := position, tokenIndex
+						if !_rules[ruleDigit]() {
+							goto l321
+						}
+						goto l320
+					l321:
+						position, tokenIndex = position320, tokenIndex320
+						if !_rules[ruleDot]() {
+							goto l315
+						}
+					}
+				l320:
+				l318:
+					{
+						position319, tokenIndex319 := position, tokenIndex
+						{
+							position322, tokenIndex322 := position, tokenIndex
+							if !_rules[ruleDigit]() {
+								goto l323
+							}
+							goto l322
+						l323:
+							position, tokenIndex = position322, tokenIndex322
+							if !_rules[ruleDot]() {
+								goto l319
+							}
+						}
+					l322:
+						goto l318
+					l319:
+						position, tokenIndex = position319, tokenIndex319
+					}
+					add(rulePegText, position317)
+				}
+				{
+					position324, tokenIndex324 := position, tokenIndex
+					{
+						position326, tokenIndex326 := position, tokenIndex
+						if !_rules[ruleAnd]() {
+							goto l325
+						}
+						position, tokenIndex = position326, tokenIndex326
+					}
+					goto l324
+				l325:
+					position, tokenIndex = position324, tokenIndex324
+					if !_rules[ruleEOF]() {
+						goto l315
+					}
+				}
+			l324:
+				if !_rules[ruleAction24]() {
+					goto l315
+				}
+				add(ruleSharpen, position316)
+			}
+			return true
+		l315:
+			position, tokenIndex = position315, tokenIndex315
+			return false
+		},
+		/* 26 Pixelate <- <(Pixelate_Key Separater <(Digit / Dot)+> (&And / EOF) Action25)> */
+		func() bool {
+			position327, tokenIndex327 := position, tokenIndex
+			{
+				position328 := position
+				if !_rules[rulePixelate_Key]() {
+					goto l327
+				}
+				if !_rules[ruleSeparater]() {
+					goto l327
+				}
+				{
+					position329 := position
+					{
+						position332, tokenIndex332 := position, tokenIndex
+						if !_rules[ruleDigit]() {
+							goto l333
+						}
+						goto l332
+					l333:
+						position, tokenIndex = position332, tokenIndex332
+						if !_rules[ruleDot]() {
+							goto l327
+						}
+					}
+				l332:
+				l330:
+					{
+						position331, tokenIndex331 := position, tokenIndex
+						{
+							position334, tokenIndex334 := position, tokenIndex
+							if !_rules[ruleDigit]() {
+								goto l335
+							}
+							goto l334
+						l335:
+							position, tokenIndex = position334, tokenIndex334
+							if !_rules[ruleDot]() {
+								goto l331
+							}
+						}
+					l334:
+						goto l330
+					l331:
+						position, tokenIndex = position331, tokenIndex331
+					}
+					add(rulePegText, position329)
+				}
+				{
+					position336, tokenIndex336 := position, tokenIndex
+					{
+						position338, tokenIndex338 := position, tokenIndex
+						if !_rules[ruleAnd]() {
+							goto l337
+						}
+						position, tokenIndex = position338, tokenIndex338
+					}
+					goto l336
+				l337:
+					position, tokenIndex = position336, tokenIndex336
+					if !_rules[ruleEOF]() {
+						goto l327
+					}
+				}
+			l336:
+				if !_rules[ruleAction25]() {
+					goto l327
+				}
+				add(rulePixelate, position328)
+			}
+			return true
+		l327:
+			position, tokenIndex = position327, tokenIndex327
+			return false
+		},
+		/* 27 Redact <- <(Redact_Key Tuple_P (&And / EOF) Action26)> */
+		func() bool {
+			position339, tokenIndex339 := position, tokenIndex
+			{
+				position340 := position
+				if !_rules[ruleRedact_Key]() {
+					goto l339
+				}
+				if !_rules[ruleTuple_P]() {
+					goto l339
+				}
+				{
+					position341, tokenIndex341 := position, tokenIndex
+					{
+						position343, tokenIndex343 := position, tokenIndex
+						if !_rules[ruleAnd]() {
+							goto l342
+						}
+						position, tokenIndex = position343, tokenIndex343
+					}
+					goto l341
+				l342:
+					position, tokenIndex = position341, tokenIndex341
+					if !_rules[ruleEOF]() {
+						goto l339
+					}
+				}
+			l341:
+				if !_rules[ruleAction26]() {
+					goto l339
+				}
+				add(ruleRedact, position340)
+			}
+			return true
+		l339:
+			position, tokenIndex = position339, tokenIndex339
+			return false
+		},
+		/* 28 RedactMode <- <(RedactMode_Key Separater <RedactModeParam> (&And / EOF) Action27)> */
+		func() bool {
+			position344, tokenIndex344 := position, tokenIndex
+			{
+				position345 := position
+				if !_rules[ruleRedactMode_Key]() {
+					goto l344
+				}
+				if !_rules[ruleSeparater]() {
+					goto l344
+				}
+				{
+					position346 := position
+					if !_rules[ruleRedactModeParam]() {
+						goto l344
+					}
+					add(rulePegText, position346)
+				}
+				{
+					position347, tokenIndex347 := position, tokenIndex
+					{
+						position349, tokenIndex349 := position, tokenIndex
+						if !_rules[ruleAnd]() {
+							goto l348
+						}
+						position, tokenIndex = position349, tokenIndex349
+					}
+					goto l347
+				l348:
+					position, tokenIndex = position347, tokenIndex347
+					if !_rules[ruleEOF]() {
+						goto l344
+					}
+				}
+			l347:
+				if !_rules[ruleAction27]() {
+					goto l344
+				}
+				add(ruleRedactMode, position345)
+			}
+			return true
+		l344:
+			position, tokenIndex = position344, tokenIndex344
+			return false
+		},
+		/* 29 Pad <- <(Pad_Key Separater <(Digit / Dot)+> (&And / EOF) Action28)> */
+		func() bool {
+			position350, tokenIndex350 := position, tokenIndex
+			{
+				position351 := position
+				if !_rules[rulePad_Key]() {
+					goto l350
+				}
+				if !_rules[ruleSeparater]() {
+					goto l350
+				}
+				{
+					position352 := position
+					{
+						position355, tokenIndex355 := position, tokenIndex
+						if !_rules[ruleDigit]() {
+							goto l356
+						}
+						goto l355
+					l356:
+						position, tokenIndex = position355, tokenIndex355
+						if !_rules[ruleDot]() {
+							goto l350
+						}
+					}
+				l355:
+				l353:
+					{
+						position354, tokenIndex354 := position, tokenIndex
+						{
+							position357, tokenIndex357 := position, tokenIndex
+							if !_rules[ruleDigit]() {
+								goto l358
+							}
+							goto l357
+						l358:
+							position, tokenIndex = position357, tokenIndex357
+							if !_rules[ruleDot]() {
+								goto l354
+							}
+						}
+					l357:
+						goto l353
+					l354:
+						position, tokenIndex = position354, tokenIndex354
+					}
+					add(rulePegText, position352)
+				}
+				{
+					position359, tokenIndex359 := position, tokenIndex
+					{
+						position361, tokenIndex361 := position, tokenIndex
+						if !_rules[ruleAnd]() {
+							goto l360
+						}
+						position, tokenIndex = position361, tokenIndex361
+					}
+					goto l359
+				l360:
+					position, tokenIndex = position359, tokenIndex359
+					if !_rules[ruleEOF]() {
+						goto l350
+					}
+				}
+			l359:
+				if !_rules[ruleAction28]() {
+					goto l350
+				}
+				add(rulePad, position351)
+			}
+			return true
+		l350:
+			position, tokenIndex = position350, tokenIndex350
+			return false
+		},
+		/* 30 PadSides <- <(Pad_Key Tuple_P (&And / EOF) Action29)> */
+		func() bool {
+			position362, tokenIndex362 := position, tokenIndex
+			{
+				position363 := position
+				if !_rules[rulePad_Key]() {
+					goto l362
+				}
+				if !_rules[ruleTuple_P]() {
+					goto l362
+				}
+				{
+					position364, tokenIndex364 := position, tokenIndex
+					{
+						position366, tokenIndex366 := position, tokenIndex
+						if !_rules[ruleAnd]() {
+							goto l365
+						}
+						position, tokenIndex = position366, tokenIndex366
+					}
+					goto l364
+				l365:
+					position, tokenIndex = position364, tokenIndex364
+					if !_rules[ruleEOF]() {
+						goto l362
+					}
+				}
+			l364:
+				if !_rules[ruleAction29]() {
+					goto l362
+				}
+				add(rulePadSides, position363)
+			}
+			return true
+		l362:
+			position, tokenIndex = position362, tokenIndex362
+			return false
+		},
+		/* 31 Border <- <(Border_Key Separater <((Digit / Dot)+ Comma HexColor)> (&And / EOF) Action30)> */
+		func() bool {
+			position367, tokenIndex367 := position, tokenIndex
+			{
+				position368 := position
+				if !_rules[ruleBorder_Key]() {
+					goto l367
+				}
+				if !_rules[ruleSeparater]() {
+					goto l367
+				}
+				{
+					position369 := position
+					{
+						position372, tokenIndex372 := position, tokenIndex
+						if !_rules[ruleDigit]() {
+							goto l373
+						}
+						goto l372
+					l373:
+						position, tokenIndex = position372, tokenIndex372
+						if !_rules[ruleDot]() {
+							goto l367
+						}
+					}
+				l372:
+				l370:
+					{
+						position371, tokenIndex371 := position, tokenIndex
+						{
+							position374, tokenIndex374 := position, tokenIndex
+							if !_rules[ruleDigit]() {
+								goto l375
+							}
+							goto l374
+						l375:
+							position, tokenIndex = position374, tokenIndex374
+							if !_rules[ruleDot]() {
+								goto l371
+							}
+						}
+					l374:
+						goto l370
+					l371:
+						position, tokenIndex = position371, tokenIndex371
+					}
+					if !_rules[ruleComma]() {
+						goto l367
+					}
+					if !_rules[ruleHexColor]() {
+						goto l367
+					}
+					add(rulePegText, position369)
+				}
+				{
+					position376, tokenIndex376 := position, tokenIndex
+					{
+						position378, tokenIndex378 := position, tokenIndex
+						if !_rules[ruleAnd]() {
+							goto l377
+						}
+						position, tokenIndex = position378, tokenIndex378
+					}
+					goto l376
+				l377:
+					position, tokenIndex = position376, tokenIndex376
+					if !_rules[ruleEOF]() {
+						goto l367
+					}
+				}
+			l376:
+				if !_rules[ruleAction30]() {
+					goto l367
+				}
+				add(ruleBorder, position368)
+			}
+			return true
+		l367:
+			position, tokenIndex = position367, tokenIndex367
+			return false
+		},
+		/* 32 Mark <- <(Mark_Key Separater <Path> (&And / EOF) Action31)> */
+		func() bool {
+			position379, tokenIndex379 := position, tokenIndex
+			{
+				position380 := position
+				if !_rules[ruleMark_Key]() {
+					goto l379
+				}
+				if !_rules[ruleSeparater]() {
+					goto l379
+				}
+				{
+					position381 := position
+					if !_rules[rulePath]() {
+						goto l379
+					}
+					add(rulePegText, position381)
+				}
+				{
+					position382, tokenIndex382 := position, tokenIndex
+					{
+						position384, tokenIndex384 := position, tokenIndex
+						if !_rules[ruleAnd]() {
+							goto l383
+						}
+						position, tokenIndex = position384, tokenIndex384
+					}
+					goto l382
+				l383:
+					position, tokenIndex = position382, tokenIndex382
+					if !_rules[ruleEOF]() {
+						goto l379
+					}
+				}
+			l382:
+				if !_rules[ruleAction31]() {
+					goto l379
+				}
+				add(ruleMark, position380)
+			}
+			return true
+		l379:
+			position, tokenIndex = position379, tokenIndex379
+			return false
+		},
+		/* 33 MarkWidth <- <(MarkWidth_Key Separater <(Digit / Dot)+> (&And / EOF) Action32)> */
+		func() bool {
+			position385, tokenIndex385 := position, tokenIndex
+			{
+				position386 := position
+				if !_rules[ruleMarkWidth_Key]() {
+					goto l385
+				}
+				if !_rules[ruleSeparater]() {
+					goto l385
+				}
+				{
+					position387 := position
+					{
+						position390, tokenIndex390 := position, tokenIndex
+						if !_rules[ruleDigit]() {
+							goto l391
+						}
+						goto l390
+					l391:
+						position, tokenIndex = position390, tokenIndex390
+						if !_rules[ruleDot]() {
+							goto l385
+						}
+					}
+				l390:
+				l388:
+					{
+						position389, tokenIndex389 := position, tokenIndex
+						{
+							position392, tokenIndex392 := position, tokenIndex
+							if !_rules[ruleDigit]() {
+								goto l393
+							}
+							goto l392
+						l393:
+							position, tokenIndex = position392, tokenIndex392
+							if !_rules[ruleDot]() {
+								goto l389
+							}
+						}
+					l392:
+						goto l388
+					l389:
+						position, tokenIndex = position389, tokenIndex389
+					}
+					add(rulePegText, position387)
+				}
+				{
+					position394, tokenIndex394 := position, tokenIndex
+					{
+						position396, tokenIndex396 := position, tokenIndex
+						if !_rules[ruleAnd]() {
+							goto l395
+						}
+						position, tokenIndex = position396, tokenIndex396
+					}
+					goto l394
+				l395:
+					position, tokenIndex = position394, tokenIndex394
+					if !_rules[ruleEOF]() {
+						goto l385
+					}
+				}
+			l394:
+				if !_rules[ruleAction32]() {
+					goto l385
+				}
+				add(ruleMarkWidth, position386)
+			}
+			return true
+		l385:
+			position, tokenIndex = position385, tokenIndex385
+			return false
+		},
+		/* 34 MarkAlign <- <(MarkAlign_Key Separater <(AlignParam (Comma AlignParam)?)> (&And / EOF) Action33)> */
+		func() bool {
+			position397, tokenIndex397 := position, tokenIndex
+			{
+				position398 := position
+				if !_rules[ruleMarkAlign_Key]() {
+					goto l397
+				}
+				if !_rules[ruleSeparater]() {
+					goto l397
+				}
+				{
+					position399 := position
+					if !_rules[ruleAlignParam]() {
+						goto l397
+					}
+					{
+						position400, tokenIndex400 := position, tokenIndex
+						if !_rules[ruleComma]() {
+							goto l400
+						}
+						if !_rules[ruleAlignParam]() {
+							goto l400
+						}
+						goto l401
+					l400:
+						position, tokenIndex = position400, tokenIndex400
+					}
+				l401:
+					add(rulePegText, position399)
+				}
+				{
+					position402, tokenIndex402 := position, tokenIndex
+					{
+						position404, tokenIndex404 := position, tokenIndex
+						if !_rules[ruleAnd]() {
+							goto l403
+						}
+						position, tokenIndex = position404, tokenIndex404
+					}
+					goto l402
+				l403:
+					position, tokenIndex = position402, tokenIndex402
+					if !_rules[ruleEOF]() {
+						goto l397
+					}
+				}
+			l402:
+				if !_rules[ruleAction33]() {
+					goto l397
+				}
+				add(ruleMarkAlign, position398)
+			}
+			return true
+		l397:
+			position, tokenIndex = position397, tokenIndex397
+			return false
+		},
+		/* 35 MarkPad <- <(MarkPad_Key Separater <(Digit / Dot)+> (&And / EOF) Action34)> */
+		func() bool {
+			position405, tokenIndex405 := position, tokenIndex
+			{
+				position406 := position
+				if !_rules[ruleMarkPad_Key]() {
+					goto l405
+				}
+				if !_rules[ruleSeparater]() {
+					goto l405
+				}
+				{
+					position407 := position
+					{
+						position410, tokenIndex410 := position, tokenIndex
+						if !_rules[ruleDigit]() {
+							goto l411
+						}
+						goto l410
+					l411:
+						position, tokenIndex = position410, tokenIndex410
+						if !_rules[ruleDot]() {
+							goto l405
+						}
+					}
+				l410:
+				l408:
+					{
+						position409, tokenIndex409 := position, tokenIndex
+						{
+							position412, tokenIndex412 := position, tokenIndex
+							if !_rules[ruleDigit]() {
+								goto l413
+							}
+							goto l412
+						l413:
+							position, tokenIndex = position412, tokenIndex412
+							if !_rules[ruleDot]() {
+								goto l409
+							}
+						}
+					l412:
+						goto l408
+					l409:
+						position, tokenIndex = position409, tokenIndex409
+					}
+					add(rulePegText, position407)
+				}
+				{
+					position414, tokenIndex414 := position, tokenIndex
+					{
+						position416, tokenIndex416 := position, tokenIndex
+						if !_rules[ruleAnd]() {
+							goto l415
+						}
+						position, tokenIndex = position416, tokenIndex416
+					}
+					goto l414
+				l415:
+					position, tokenIndex = position414, tokenIndex414
+					if !_rules[ruleEOF]() {
+						goto l405
+					}
+				}
+			l414:
+				if !_rules[ruleAction34]() {
+					goto l405
+				}
+				add(ruleMarkPad, position406)
+			}
+			return true
+		l405:
+			position, tokenIndex = position405, tokenIndex405
+			return false
+		},
+		/* 36 MarkAlpha <- <(MarkAlpha_Key Separater <(Digit / Dot)+> (&And / EOF) Action35)> */
+		func() bool {
+			position417, tokenIndex417 := position, tokenIndex
+			{
+				position418 := position
+				if !_rules[ruleMarkAlpha_Key]() {
+					goto l417
+				}
+				if !_rules[ruleSeparater]() {
+					goto l417
+				}
+				{
+					position419 := position
+					{
+						position422, tokenIndex422 := position, tokenIndex
+						if !_rules[ruleDigit]() {
+							goto l423
+						}
+						goto l422
+					l423:
+						position, tokenIndex = position422, tokenIndex422
+						if !_rules[ruleDot]() {
+							goto l417
+						}
+					}
+				l422:
+				l420:
+					{
+						position421, tokenIndex421 := position, tokenIndex
+						{
+							position424, tokenIndex424 := position, tokenIndex
+							if !_rules[ruleDigit]() {
+								goto l425
+							}
+							goto l424
+						l425:
+							position, tokenIndex = position424, tokenIndex424
+							if !_rules[ruleDot]() {
+								goto l421
+							}
+						}
+					l424:
+						goto l420
+					l421:
+						position, tokenIndex = position421, tokenIndex421
+					}
+					add(rulePegText, position419)
+				}
+				{
+					position426, tokenIndex426 := position, tokenIndex
+					{
+						position428, tokenIndex428 := position, tokenIndex
+						if !_rules[ruleAnd]() {
+							goto l427
+						}
+						position, tokenIndex = position428, tokenIndex428
+					}
+					goto l426
+				l427:
+					position, tokenIndex = position426, tokenIndex426
+					if !_rules[ruleEOF]() {
+						goto l417
+					}
+				}
+			l426:
+				if !_rules[ruleAction35]() {
+					goto l417
+				}
+				add(ruleMarkAlpha, position418)
+			}
+			return true
+		l417:
+			position, tokenIndex = position417, tokenIndex417
+			return false
+		},
+		/* 37 MarkScale <- <(MarkScale_Key Separater <(Digit / Dot)+> (&And / EOF) Action36)> */
+		func() bool {
+			position429, tokenIndex429 := position, tokenIndex
+			{
+				position430 := position
+				if !_rules[ruleMarkScale_Key]() {
+					goto l429
+				}
+				if !_rules[ruleSeparater]() {
+					goto l429
+				}
+				{
+					position431 := position
+					{
+						position434, tokenIndex434 := position, tokenIndex
+						if !_rules[ruleDigit]() {
+							goto l435
+						}
+						goto l434
+					l435:
+						position, tokenIndex = position434, tokenIndex434
+						if !_rules[ruleDot]() {
+							goto l429
+						}
+					}
+				l434:
+				l432:
+					{
+						position433, tokenIndex433 := position, tokenIndex
+						{
+							position436, tokenIndex436 := position, tokenIndex
+							if !_rules[ruleDigit]() {
+								goto l437
+							}
+							goto l436
+						l437:
+							position, tokenIndex = position436, tokenIndex436
+							if !_rules[ruleDot]() {
+								goto l433
+							}
+						}
+					l436:
+						goto l432
+					l433:
+						position, tokenIndex = position433, tokenIndex433
+					}
+					add(rulePegText, position431)
+				}
+				{
+					position438, tokenIndex438 := position, tokenIndex
+					{
+						position440, tokenIndex440 := position, tokenIndex
+						if !_rules[ruleAnd]() {
+							goto l439
+						}
+						position, tokenIndex = position440, tokenIndex440
+					}
+					goto l438
+				l439:
+					position, tokenIndex = position438, tokenIndex438
+					if !_rules[ruleEOF]() {
+						goto l429
+					}
+				}
+			l438:
+				if !_rules[ruleAction36]() {
+					goto l429
+				}
+				add(ruleMarkScale, position430)
+			}
+			return true
+		l429:
+			position, tokenIndex = position429, tokenIndex429
+			return false
+		},
+		/* 38 Text <- <(Text_Key Separater <UrlText> (&And / EOF) Action37)> */
+		func() bool {
+			position441, tokenIndex441 := position, tokenIndex
+			{
+				position442 := position
+				if !_rules[ruleText_Key]() {
+					goto l441
+				}
+				if !_rules[ruleSeparater]() {
+					goto l441
+				}
+				{
+					position443 := position
+					if !_rules[ruleUrlText]() {
+						goto l441
+					}
+					add(rulePegText, position443)
+				}
+				{
+					position444, tokenIndex444 := position, tokenIndex
+					{
+						position446, tokenIndex446 := position, tokenIndex
+						if !_rules[ruleAnd]() {
+							goto l445
+						}
+						position, tokenIndex = position446, tokenIndex446
+					}
+					goto l444
+				l445:
+					position, tokenIndex = position444, tokenIndex444
+					if !_rules[ruleEOF]() {
+						goto l441
+					}
+				}
+			l444:
+				if !_rules[ruleAction37]() {
+					goto l441
+				}
+				add(ruleText, position442)
+			}
+			return true
+		l441:
+			position, tokenIndex = position441, tokenIndex441
+			return false
+		},
+		/* 39 TextSize <- <(TextSize_Key Separater <(Digit / Dot)+> (&And / EOF) Action38)> */
+		func() bool {
+			position447, tokenIndex447 := position, tokenIndex
+			{
+				position448 := position
+				if !_rules[ruleTextSize_Key]() {
+					goto l447
+				}
+				if !_rules[ruleSeparater]() {
+					goto l447
+				}
+				{
+					position449 := position
+					{
+						position452, tokenIndex452 := position, tokenIndex
+						if !_rules[ruleDigit]() {
+							goto l453
+						}
+						goto l452
+					l453:
+						position, tokenIndex = position452, tokenIndex452
+						if !_rules[ruleDot]() {
+							goto l447
+						}
+					}
+				l452:
+				l450:
+					{
+						position451, tokenIndex451 := position, tokenIndex
+						{
+							position454, tokenIndex454 := position, tokenIndex
+							if !_rules[ruleDigit]() {
+								goto l455
+							}
+							goto l454
+						l455:
+							position, tokenIndex = position454, tokenIndex454
+							if !_rules[ruleDot]() {
+								goto l451
+							}
+						}
+					l454:
+						goto l450
+					l451:
+						position, tokenIndex = position451, tokenIndex451
+					}
+					add(rulePegText, position449)
+				}
+				{
+					position456, tokenIndex456 := position, tokenIndex
+					{
+						position458, tokenIndex458 := position, tokenIndex
+						if !_rules[ruleAnd]() {
+							goto l457
+						}
+						position, tokenIndex = position458, tokenIndex458
+					}
+					goto l456
+				l457:
+					position, tokenIndex = position456, tokenIndex456
+					if !_rules[ruleEOF]() {
+						goto l447
+					}
+				}
+			l456:
+				if !_rules[ruleAction38]() {
+					goto l447
+				}
+				add(ruleTextSize, position448)
+			}
+			return true
+		l447:
+			position, tokenIndex = position447, tokenIndex447
+			return false
+		},
+		/* 40 TextColor <- <(TextColor_Key Separater <HexColor> (&And / EOF) Action39)> */
+		func() bool {
+			position459, tokenIndex459 := position, tokenIndex
+			{
+				position460 := position
+				if !_rules[ruleTextColor_Key]() {
+					goto l459
+				}
+				if !_rules[ruleSeparater]() {
+					goto l459
+				}
+				{
+					position461 := position
+					if !_rules[ruleHexColor]() {
+						goto l459
+					}
+					add(rulePegText, position461)
+				}
+				{
+					position462, tokenIndex462 := position, tokenIndex
+					{
+						position464, tokenIndex464 := position, tokenIndex
+						if !_rules[ruleAnd]() {
+							goto l463
+						}
+						position, tokenIndex = position464, tokenIndex464
+					}
+					goto l462
+				l463:
+					position, tokenIndex = position462, tokenIndex462
+					if !_rules[ruleEOF]() {
+						goto l459
+					}
+				}
+			l462:
+				if !_rules[ruleAction39]() {
+					goto l459
+				}
+				add(ruleTextColor, position460)
+			}
+			return true
+		l459:
+			position, tokenIndex = position459, tokenIndex459
+			return false
+		},
+		/* 41 TextAlign <- <(TextAlign_Key Separater <(AlignParam (Comma AlignParam)?)> (&And / EOF) Action40)> */
+		func() bool {
+			position465, tokenIndex465 := position, tokenIndex
+			{
+				position466 := position
+				if !_rules[ruleTextAlign_Key]() {
+					goto l465
+				}
+				if !_rules[ruleSeparater]() {
+					goto l465
+				}
+				{
+					position467 := position
+					if !_rules[ruleAlignParam]() {
+						goto l465
+					}
+					{
+						position468, tokenIndex468 := position, tokenIndex
+						if !_rules[ruleComma]() {
+							goto l468
+						}
+						if !_rules[ruleAlignParam]() {
+							goto l468
+						}
+						goto l469
+					l468:
+						position, tokenIndex = position468, tokenIndex468
+					}
+				l469:
+					add(rulePegText, position467)
+				}
+				{
+					position470, tokenIndex470 := position, tokenIndex
+					{
+						position472, tokenIndex472 := position, tokenIndex
+						if !_rules[ruleAnd]() {
+							goto l471
+						}
+						position, tokenIndex = position472, tokenIndex472
+					}
+					goto l470
+				l471:
+					position, tokenIndex = position470, tokenIndex470
+					if !_rules[ruleEOF]() {
+						goto l465
+					}
+				}
+			l470:
+				if !_rules[ruleAction40]() {
+					goto l465
+				}
+				add(ruleTextAlign, position466)
+			}
+			return true
+		l465:
+			position, tokenIndex = position465, tokenIndex465
+			return false
+		},
+		/* 42 TextPad <- <(TextPad_Key Separater <(Digit / Dot)+> (&And / EOF) Action41)> */
+		func() bool {
+			position473, tokenIndex473 := position, tokenIndex
+			{
+				position474 := position
+				if !_rules[ruleTextPad_Key]() {
+					goto l473
+				}
+				if !_rules[ruleSeparater]() {
+					goto l473
+				}
+				{
+					position475 := position
+					{
+						position478, tokenIndex478 := position, tokenIndex
+						if !_rules[ruleDigit]() {
+							goto l479
+						}
+						goto l478
+					l479:
+						position, tokenIndex = position478, tokenIndex478
+						if !_rules[ruleDot]() {
+							goto l473
+						}
+					}
+				l478:
+				l476:
+					{
+						position477, tokenIndex477 := position, tokenIndex
+						{
+							position480, tokenIndex480 := position, tokenIndex
+							if !_rules[ruleDigit]() {
+								goto l481
+							}
+							goto l480
+						l481:
+							position, tokenIndex = position480, tokenIndex480
+							if !_rules[ruleDot]() {
+								goto l477
+							}
+						}
+					l480:
+						goto l476
+					l477:
+						position, tokenIndex = position477, tokenIndex477
+					}
+					add(rulePegText, position475)
+				}
+				{
+					position482, tokenIndex482 := position, tokenIndex
+					{
+						position484, tokenIndex484 := position, tokenIndex
+						if !_rules[ruleAnd]() {
+							goto l483
+						}
+						position, tokenIndex = position484, tokenIndex484
+					}
+					goto l482
+				l483:
+					position, tokenIndex = position482, tokenIndex482
+					if !_rules[ruleEOF]() {
+						goto l473
+					}
+				}
+			l482:
+				if !_rules[ruleAction41]() {
+					goto l473
+				}
+				add(ruleTextPad, position474)
+			}
+			return true
+		l473:
+			position, tokenIndex = position473, tokenIndex473
+			return false
+		},
+		/* 43 TextFont <- <(TextFont_Key Separater <LowerCase> (&And / EOF) Action42)> */
+		func() bool {
+			position485, tokenIndex485 := position, tokenIndex
+			{
+				position486 := position
+				if !_rules[ruleTextFont_Key]() {
+					goto l485
+				}
+				if !_rules[ruleSeparater]() {
+					goto l485
+				}
+				{
+					position487 := position
+					if !_rules[ruleLowerCase]() {
+						goto l485
+					}
+					add(rulePegText, position487)
+				}
+				{
+					position488, tokenIndex488 := position, tokenIndex
+					{
+						position490, tokenIndex490 := position, tokenIndex
+						if !_rules[ruleAnd]() {
+							goto l489
+						}
+						position, tokenIndex = position490, tokenIndex490
+					}
+					goto l488
+				l489:
+					position, tokenIndex = position488, tokenIndex488
+					if !_rules[ruleEOF]() {
+						goto l485
+					}
+				}
+			l488:
+				if !_rules[ruleAction42]() {
+					goto l485
+				}
+				add(ruleTextFont, position486)
+			}
+			return true
+		l485:
+			position, tokenIndex = position485, tokenIndex485
+			return false
+		},
+		/* 44 CornerRadius <- <(CornerRadius_Key Separater <(Digit / Dot)+> (&And / EOF) Action43)> */
+		func() bool {
+			position491, tokenIndex491 := position, tokenIndex
+			{
+				position492 := position
+				if !_rules[ruleCornerRadius_Key]() {
+					goto l491
+				}
+				if !_rules[ruleSeparater]() {
+					goto l491
+				}
+				{
+					position493 := position
+					{
+						position496, tokenIndex496 := position, tokenIndex
+						if !_rules[ruleDigit]() {
+							goto l497
+						}
+						goto l496
+					l497:
+						position, tokenIndex = position496, tokenIndex496
+						if !_rules[ruleDot]() {
+							goto l491
+						}
+					}
+				l496:
+				l494:
+					{
+						position495, tokenIndex495 := position, tokenIndex
+						{
+							position498, tokenIndex498 := position, tokenIndex
+							if !_rules[ruleDigit]() {
+								goto l499
+							}
+							goto l498
+						l499:
+							position, tokenIndex = position498, tokenIndex498
+							if !_rules[ruleDot]() {
+								goto l495
+							}
+						}
+					l498:
+						goto l494
+					l495:
+						position, tokenIndex = position495, tokenIndex495
+					}
+					add(rulePegText, position493)
+				}
+				{
+					position500, tokenIndex500 := position, tokenIndex
+					{
+						position502, tokenIndex502 := position, tokenIndex
+						if !_rules[ruleAnd]() {
+							goto l501
+						}
+						position, tokenIndex = position502, tokenIndex502
+					}
+					goto l500
+				l501:
+					position, tokenIndex = position500, tokenIndex500
+					if !_rules[ruleEOF]() {
+						goto l491
+					}
+				}
+			l500:
+				if !_rules[ruleAction43]() {
+					goto l491
+				}
+				add(ruleCornerRadius, position492)
+			}
+			return true
+		l491:
+			position, tokenIndex = position491, tokenIndex491
+			return false
+		},
+		/* 45 Mask <- <(Mask_Key Separater <Path> (&And / EOF) Action44)> */
+		func() bool {
+			position503, tokenIndex503 := position, tokenIndex
+			{
+				position504 := position
+				if !_rules[ruleMask_Key]() {
+					goto l503
+				}
+				if !_rules[ruleSeparater]() {
+					goto l503
+				}
+				{
+					position505 := position
+					if !_rules[rulePath]() {
+						goto l503
+					}
+					add(rulePegText, position505)
+				}
+				{
+					position506, tokenIndex506 := position, tokenIndex
+					{
+						position508, tokenIndex508 := position, tokenIndex
+						if !_rules[ruleAnd]() {
+							goto l507
+						}
+						position, tokenIndex = position508, tokenIndex508
+					}
+					goto l506
+				l507:
+					position, tokenIndex = position506, tokenIndex506
+					if !_rules[ruleEOF]() {
+						goto l503
+					}
+				}
+			l506:
+				if !_rules[ruleAction44]() {
+					goto l503
+				}
+				add(ruleMask, position504)
+			}
+			return true
+		l503:
+			position, tokenIndex = position503, tokenIndex503
+			return false
+		},
+		/* 46 Trim <- <(Trim_Key Separater <TrimParam> (&And / EOF) Action45)> */
+		func() bool {
+			position509, tokenIndex509 := position, tokenIndex
+			{
+				position510 := position
+				if !_rules[ruleTrim_Key]() {
+					goto l509
+				}
+				if !_rules[ruleSeparater]() {
+					goto l509
+				}
+				{
+					position511 := position
+					if !_rules[ruleTrimParam]() {
+						goto l509
+					}
+					add(rulePegText, position511)
+				}
+				{
+					position512, tokenIndex512 := position, tokenIndex
+					{
+						position514, tokenIndex514 := position, tokenIndex
+						if !_rules[ruleAnd]() {
+							goto l513
+						}
+						position, tokenIndex = position514, tokenIndex514
+					}
+					goto l512
+				l513:
+					position, tokenIndex = position512, tokenIndex512
+					if !_rules[ruleEOF]() {
+						goto l509
+					}
+				}
+			l512:
+				if !_rules[ruleAction45]() {
+					goto l509
+				}
+				add(ruleTrim, position510)
+			}
+			return true
+		l509:
+			position, tokenIndex = position509, tokenIndex509
+			return false
+		},
+		/* 47 TrimTol <- <(TrimTol_Key Separater <(Digit / Dot)+> (&And / EOF) Action46)> */
+		func() bool {
+			position515, tokenIndex515 := position, tokenIndex
+			{
+				position516 := position
+				if !_rules[ruleTrimTol_Key]() {
+					goto l515
+				}
+				if !_rules[ruleSeparater]() {
+					goto l515
+				}
+				{
+					position517 := position
+					{
+						position520, tokenIndex520 := position, tokenIndex
+						if !_rules[ruleDigit]() {
+							goto l521
+						}
+						goto l520
+					l521:
+						position, tokenIndex = position520, tokenIndex520
+						if !_rules[ruleDot]() {
+							goto l515
+						}
+					}
+				l520:
+				l518:
+					{
+						position519, tokenIndex519 := position, tokenIndex
+						{
+							position522, tokenIndex522 := position, tokenIndex
+							if !_rules[ruleDigit]() {
+								goto l523
+							}
+							goto l522
+						l523:
+							position, tokenIndex = position522, tokenIndex522
+							if !_rules[ruleDot]() {
+								goto l519
+							}
+						}
+					l522:
+						goto l518
+					l519:
+						position, tokenIndex = position519, tokenIndex519
+					}
+					add(rulePegText, position517)
+				}
+				{
+					position524, tokenIndex524 := position, tokenIndex
+					{
+						position526, tokenIndex526 := position, tokenIndex
+						if !_rules[ruleAnd]() {
+							goto l525
+						}
+						position, tokenIndex = position526, tokenIndex526
+					}
+					goto l524
+				l525:
+					position, tokenIndex = position524, tokenIndex524
+					if !_rules[ruleEOF]() {
+						goto l515
+					}
+				}
+			l524:
+				if !_rules[ruleAction46]() {
+					goto l515
+				}
+				add(ruleTrimTol, position516)
+			}
+			return true
+		l515:
+			position, tokenIndex = position515, tokenIndex515
+			return false
+		},
+		/* 48 TrimColor <- <(TrimColor_Key Separater <HexColor> (&And / EOF) Action47)> */
+		func() bool {
+			position527, tokenIndex527 := position, tokenIndex
+			{
+				position528 := position
+				if !_rules[ruleTrimColor_Key]() {
+					goto l527
+				}
+				if !_rules[ruleSeparater]() {
+					goto l527
+				}
+				{
+					position529 := position
+					if !_rules[ruleHexColor]() {
+						goto l527
+					}
+					add(rulePegText, position529)
+				}
+				{
+					position530, tokenIndex530 := position, tokenIndex
+					{
+						position532, tokenIndex532 := position, tokenIndex
+						if !_rules[ruleAnd]() {
+							goto l531
+						}
+						position, tokenIndex = position532, tokenIndex532
+					}
+					goto l530
+				l531:
+					position, tokenIndex = position530, tokenIndex530
+					if !_rules[ruleEOF]() {
+						goto l527
+					}
+				}
+			l530:
+				if !_rules[ruleAction47]() {
+					goto l527
+				}
+				add(ruleTrimColor, position528)
+			}
+			return true
+		l527:
+			position, tokenIndex = position527, tokenIndex527
+			return false
+		},
+		/* 49 Filter <- <(Filter_Key Separater <FilterParam> (&And / EOF) Action48)> */
+		func() bool {
+			position533, tokenIndex533 := position, tokenIndex
+			{
+				position534 := position
+				if !_rules[ruleFilter_Key]() {
+					goto l533
+				}
+				if !_rules[ruleSeparater]() {
+					goto l533
+				}
+				{
+					position535 := position
+					if !_rules[ruleFilterParam]() {
+						goto l533
+					}
+					add(rulePegText, position535)
+				}
+				{
+					position536, tokenIndex536 := position, tokenIndex
+					{
+						position538, tokenIndex538 := position, tokenIndex
+						if !_rules[ruleAnd]() {
+							goto l537
+						}
+						position, tokenIndex = position538, tokenIndex538
+					}
+					goto l536
+				l537:
+					position, tokenIndex = position536, tokenIndex536
+					if !_rules[ruleEOF]() {
+						goto l533
+					}
+				}
+			l536:
+				if !_rules[ruleAction48]() {
+					goto l533
+				}
+				add(ruleFilter, position534)
+			}
+			return true
+		l533:
+			position, tokenIndex = position533, tokenIndex533
+			return false
+		},
+		/* 50 Upscale <- <(Upscale_Key Separater <Bool> (&And / EOF) Action49)> */
+		func() bool {
+			position539, tokenIndex539 := position, tokenIndex
+			{
+				position540 := position
+				if !_rules[ruleUpscale_Key]() {
+					goto l539
+				}
+				if !_rules[ruleSeparater]() {
+					goto l539
+				}
+				{
+					position541 := position
+					if !_rules[ruleBool]() {
+						goto l539
+					}
+					add(rulePegText, position541)
+				}
+				{
+					position542, tokenIndex542 := position, tokenIndex
+					{
+						position544, tokenIndex544 := position, tokenIndex
+						if !_rules[ruleAnd]() {
+							goto l543
+						}
+						position, tokenIndex = position544, tokenIndex544
+					}
+					goto l542
+				l543:
+					position, tokenIndex = position542, tokenIndex542
+					if !_rules[ruleEOF]() {
+						goto l539
+					}
+				}
+			l542:
+				if !_rules[ruleAction49]() {
+					goto l539
+				}
+				add(ruleUpscale, position540)
+			}
+			return true
+		l539:
+			position, tokenIndex = position539, tokenIndex539
+			return false
+		},
+		/* 51 Palette <- <(Palette_Key Separater <PaletteParam> (&And / EOF) Action50)> */
+		func() bool {
+			position545, tokenIndex545 := position, tokenIndex
+			{
+				position546 := position
+				if !_rules[rulePalette_Key]() {
+					goto l545
+				}
+				if !_rules[ruleSeparater]() {
+					goto l545
+				}
+				{
+					position547 := position
+					if !_rules[rulePaletteParam]() {
+						goto l545
+					}
+					add(rulePegText, position547)
+				}
+				{
+					position548, tokenIndex548 := position, tokenIndex
+					{
+						position550, tokenIndex550 := position, tokenIndex
+						if !_rules[ruleAnd]() {
+							goto l549
+						}
+						position, tokenIndex = position550, tokenIndex550
+					}
+					goto l548
+				l549:
+					position, tokenIndex = position548, tokenIndex548
+					if !_rules[ruleEOF]() {
+						goto l545
+					}
+				}
+			l548:
+				if !_rules[ruleAction50]() {
+					goto l545
+				}
+				add(rulePalette, position546)
+			}
+			return true
+		l545:
+			position, tokenIndex = position545, tokenIndex545
+			return false
+		},
+		/* 52 Colors <- <(Colors_Key Separater <(Digit / Dot)+> (&And / EOF) Action51)> */
+		func() bool {
+			position551, tokenIndex551 := position, tokenIndex
+			{
+				position552 := position
+				if !_rules[ruleColors_Key]() {
+					goto l551
+				}
+				if !_rules[ruleSeparater]() {
+					goto l551
+				}
+				{
+					position553 := position
+					{
+						position556, tokenIndex556 := position, tokenIndex
+						if !_rules[ruleDigit]() {
+							goto l557
+						}
+						goto l556
+					l557:
+						position, tokenIndex = position556, tokenIndex556
+						if !_rules[ruleDot]() {
+							goto l551
+						}
+					}
+				l556:
+				l554:
+					{
+						position555, tokenIndex555 := position, tokenIndex
+						{
+							position558, tokenIndex558 := position, tokenIndex
+							if !_rules[ruleDigit]() {
+								goto l559
+							}
+							goto l558
+						l559:
+							position, tokenIndex = position558, tokenIndex558
+							if !_rules[ruleDot]() {
+								goto l555
+							}
+						}
+					l558:
+						goto l554
+					l555:
+						position, tokenIndex = position555, tokenIndex555
+					}
+					add(rulePegText, position553)
+				}
+				{
+					position560, tokenIndex560 := position, tokenIndex
+					{
+						position562, tokenIndex562 := position, tokenIndex
+						if !_rules[ruleAnd]() {
+							goto l561
+						}
+						position, tokenIndex = position562, tokenIndex562
+					}
+					goto l560
+				l561:
+					position, tokenIndex = position560, tokenIndex560
+					if !_rules[ruleEOF]() {
+						goto l551
+					}
+				}
+			l560:
+				if !_rules[ruleAction51]() {
+					goto l551
+				}
+				add(ruleColors, position552)
+			}
+			return true
+		l551:
+			position, tokenIndex = position551, tokenIndex551
+			return false
+		},
+		/* 53 Lqip <- <(Lqip_Key Separater <LqipParam> (&And / EOF) Action52)> */
+		func() bool {
+			position563, tokenIndex563 := position, tokenIndex
+			{
+				position564 := position
+				if !_rules[ruleLqip_Key]() {
+					goto l563
+				}
+				if !_rules[ruleSeparater]() {
+					goto l563
+				}
+				{
+					position565 := position
+					if !_rules[ruleLqipParam]() {
+						goto l563
+					}
+					add(rulePegText, position565)
+				}
+				{
+					position566, tokenIndex566 := position, tokenIndex
+					{
+						position568, tokenIndex568 := position, tokenIndex
+						if !_rules[ruleAnd]() {
+							goto l567
+						}
+						position, tokenIndex = position568, tokenIndex568
+					}
+					goto l566
+				l567:
+					position, tokenIndex = position566, tokenIndex566
+					if !_rules[ruleEOF]() {
+						goto l563
+					}
+				}
+			l566:
+				if !_rules[ruleAction52]() {
+					goto l563
+				}
+				add(ruleLqip, position564)
+			}
+			return true
+		l563:
+			position, tokenIndex = position563, tokenIndex563
+			return false
+		},
+		/* 54 PHash <- <(PHash_Key Separater <PHashParam> (&And / EOF) Action53)> */
+		func() bool {
+			position569, tokenIndex569 := position, tokenIndex
+			{
+				position570 := position
+				if !_rules[rulePHash_Key]() {
+					goto l569
+				}
+				if !_rules[ruleSeparater]() {
+					goto l569
+				}
+				{
+					position571 := position
+					if !_rules[rulePHashParam]() {
+						goto l569
+					}
+					add(rulePegText, position571)
+				}
+				{
+					position572, tokenIndex572 := position, tokenIndex
+					{
+						position574, tokenIndex574 := position, tokenIndex
+						if !_rules[ruleAnd]() {
+							goto l573
+						}
+						position, tokenIndex = position574, tokenIndex574
+					}
+					goto l572
+				l573:
+					position, tokenIndex = position572, tokenIndex572
+					if !_rules[ruleEOF]() {
+						goto l569
+					}
+				}
+			l572:
+				if !_rules[ruleAction53]() {
+					goto l569
+				}
+				add(rulePHash, position570)
+			}
+			return true
+		l569:
+			position, tokenIndex = position569, tokenIndex569
+			return false
+		},
+		/* 55 Frame <- <(Frame_Key Separater <Digit> (&And / EOF) Action54)> */
+		func() bool {
+			position575, tokenIndex575 := position, tokenIndex
+			{
+				position576 := position
+				if !_rules[ruleFrame_Key]() {
+					goto l575
+				}
+				if !_rules[ruleSeparater]() {
+					goto l575
+				}
+				{
+					position577 := position
+					if !_rules[ruleDigit]() {
+						goto l575
+					}
+					add(rulePegText, position577)
+				}
+				{
+					position578, tokenIndex578 := position, tokenIndex
+					{
+						position580, tokenIndex580 := position, tokenIndex
+						if !_rules[ruleAnd]() {
+							goto l579
+						}
+						position, tokenIndex = position580, tokenIndex580
+					}
+					goto l578
+				l579:
+					position, tokenIndex = position578, tokenIndex578
+					if !_rules[ruleEOF]() {
+						goto l575
+					}
+				}
+			l578:
+				if !_rules[ruleAction54]() {
+					goto l575
+				}
+				add(ruleFrame, position576)
+			}
+			return true
+		l575:
+			position, tokenIndex = position575, tokenIndex575
+			return false
+		},
+		/* 56 MaxBytes <- <(MaxBytes_Key Separater <Digit> (&And / EOF) Action55)> */
+		func() bool {
+			position581, tokenIndex581 := position, tokenIndex
+			{
+				position582 := position
+				if !_rules[ruleMaxBytes_Key]() {
+					goto l581
+				}
+				if !_rules[ruleSeparater]() {
+					goto l581
+				}
+				{
+					position583 := position
+					if !_rules[ruleDigit]() {
+						goto l581
+					}
+					add(rulePegText, position583)
+				}
+				{
+					position584, tokenIndex584 := position, tokenIndex
+					{
+						position586, tokenIndex586 := position, tokenIndex
+						if !_rules[ruleAnd]() {
+							goto l585
+						}
+						position, tokenIndex = position586, tokenIndex586
+					}
+					goto l584
+				l585:
+					position, tokenIndex = position584, tokenIndex584
+					if !_rules[ruleEOF]() {
+						goto l581
+					}
+				}
+			l584:
+				if !_rules[ruleAction55]() {
+					goto l581
+				}
+				add(ruleMaxBytes, position582)
+			}
+			return true
+		l581:
+			position, tokenIndex = position581, tokenIndex581
+			return false
+		},
+		/* 57 Lossless <- <(Lossless_Key Separater <Bool> (&And / EOF) Action56)> */
+		func() bool {
+			position587, tokenIndex587 := position, tokenIndex
+			{
+				position588 := position
+				if !_rules[ruleLossless_Key]() {
+					goto l587
+				}
+				if !_rules[ruleSeparater]() {
+					goto l587
+				}
+				{
+					position589 := position
+					if !_rules[ruleBool]() {
+						goto l587
+					}
+					add(rulePegText, position589)
+				}
+				{
+					position590, tokenIndex590 := position, tokenIndex
+					{
+						position592, tokenIndex592 := position, tokenIndex
+						if !_rules[ruleAnd]() {
+							goto l591
+						}
+						position, tokenIndex = position592, tokenIndex592
+					}
+					goto l590
+				l591:
+					position, tokenIndex = position590, tokenIndex590
+					if !_rules[ruleEOF]() {
+						goto l587
+					}
+				}
+			l590:
+				if !_rules[ruleAction56]() {
+					goto l587
+				}
+				add(ruleLossless, position588)
+			}
+			return true
+		l587:
+			position, tokenIndex = position587, tokenIndex587
+			return false
+		},
+		/* 58 Dither <- <(Dither_Key Separater <Bool> (&And / EOF) Action57)> */
+		func() bool {
+			position593, tokenIndex593 := position, tokenIndex
+			{
+				position594 := position
+				if !_rules[ruleDither_Key]() {
+					goto l593
+				}
+				if !_rules[ruleSeparater]() {
+					goto l593
+				}
+				{
+					position595 := position
+					if !_rules[ruleBool]() {
+						goto l593
+					}
+					add(rulePegText, position595)
+				}
+				{
+					position596, tokenIndex596 := position, tokenIndex
+					{
+						position598, tokenIndex598 := position, tokenIndex
+						if !_rules[ruleAnd]() {
+							goto l597
+						}
+						position, tokenIndex = position598, tokenIndex598
+					}
+					goto l596
+				l597:
+					position, tokenIndex = position596, tokenIndex596
+					if !_rules[ruleEOF]() {
+						goto l593
+					}
+				}
+			l596:
+				if !_rules[ruleAction57]() {
+					goto l593
+				}
+				add(ruleDither, position594)
+			}
+			return true
+		l593:
+			position, tokenIndex = position593, tokenIndex593
+			return false
+		},
+		/* 59 SignSeparator <- <(<((Brightness_Key / Contrast_Key / Saturation_Key / Gamma_Key / Hue_Key) (Dot / Haihun / Comma) Signed)> (&And / EOF) Action58)> */
+		func() bool {
+			position599, tokenIndex599 := position, tokenIndex
+			{
+				position600 := position
+				{
+					position601 := position
+					{
+						position602, tokenIndex602 := position, tokenIndex
+						if !_rules[ruleBrightness_Key]() {
+							goto l603
+						}
+						goto l602
+					l603:
+						position, tokenIndex = position602, tokenIndex602
+						if !_rules[ruleContrast_Key]() {
+							goto l604
+						}
+						goto l602
+					l604:
+						position, tokenIndex = position602, tokenIndex602
+						if !_rules[ruleSaturation_Key]() {
+							goto l605
+						}
+						goto l602
+					l605:
+						position, tokenIndex = position602, tokenIndex602
+						if !_rules[ruleGamma_Key]() {
+							goto l606
+						}
+						goto l602
+					l606:
+						position, tokenIndex = position602, tokenIndex602
+						if !_rules[ruleHue_Key]() {
+							goto l599
+						}
+					}
+				l602:
+					{
+						position607, tokenIndex607 := position, tokenIndex
+						if !_rules[ruleDot]() {
+							goto l608
+						}
+						goto l607
+					l608:
+						position, tokenIndex = position607, tokenIndex607
+						if !_rules[ruleHaihun]() {
+							goto l609
+						}
+						goto l607
+					l609:
+						position, tokenIndex = position607, tokenIndex607
+						if !_rules[ruleComma]() {
+							goto l599
+						}
+					}
+				l607:
+					if !_rules[ruleSigned]() {
+						goto l599
+					}
+					add(rulePegText, position601)
+				}
+				{
+					position610, tokenIndex610 := position, tokenIndex
+					{
+						position612, tokenIndex612 := position, tokenIndex
+						if !_rules[ruleAnd]() {
+							goto l611
+						}
+						position, tokenIndex = position612, tokenIndex612
+					}
+					goto l610
+				l611:
+					position, tokenIndex = position610, tokenIndex610
+					if !_rules[ruleEOF]() {
+						goto l599
+					}
+				}
+			l610:
+				if !_rules[ruleAction58]() {
+					goto l599
+				}
+				add(ruleSignSeparator, position600)
+			}
+			return true
+		l599:
+			position, tokenIndex = position599, tokenIndex599
+			return false
+		},
+		/* 60 SkipParam <- <(<(All (&And / EOF))> Action59)> */
+		func() bool {
+			position613, tokenIndex613 := position, tokenIndex
+			{
+				position614 := position
+				{
+					position615 := position
+					if !_rules[ruleAll]() {
+						goto l613
+					}
+					{
+						position616, tokenIndex616 := position, tokenIndex
+						{
+							position618, tokenIndex618 := position, tokenIndex
+							if !_rules[ruleAnd]() {
+								goto l617
+							}
+							position, tokenIndex = position618, tokenIndex618
+						}
+						goto l616
+					l617:
+						position, tokenIndex = position616, tokenIndex616
+						if !_rules[ruleEOF]() {
+							goto l613
+						}
+					}
+				l616:
+					add(rulePegText, position615)
+				}
+				if !_rules[ruleAction59]() {
+					goto l613
+				}
+				add(ruleSkipParam, position614)
+			}
+			return true
+		l613:
+			position, tokenIndex = position613, tokenIndex613
+			return false
+		},
+		/* 61 Separater <- <(Equal / Dot / Haihun / Comma)> */
+		func() bool {
+			position619, tokenIndex619 := position, tokenIndex
+			{
+				position620 := position
+				{
+					position621, tokenIndex621 := position, tokenIndex
+					if !_rules[ruleEqual]() {
+						goto l622
+					}
+					goto l621
+				l622:
+					position, tokenIndex = position621, tokenIndex621
+					if !_rules[ruleDot]() {
+						goto l623
+					}
+					goto l621
+				l623:
+					position, tokenIndex = position621, tokenIndex621
+					if !_rules[ruleHaihun]() {
+						goto l624
+					}
+					goto l621
+				l624:
+					position, tokenIndex = position621, tokenIndex621
+					if !_rules[ruleComma]() {
+						goto l619
+					}
+				}
+			l621:
+				add(ruleSeparater, position620)
+			}
+			return true
+		l619:
+			position, tokenIndex = position619, tokenIndex619
+			return false
+		},
+		/* 62 Delimiter <- <(Question / And)> */
+		func() bool {
+			position625, tokenIndex625 := position, tokenIndex
+			{
+				position626 := position
+				{
+					position627, tokenIndex627 := position, tokenIndex
+					if !_rules[ruleQuestion]() {
+						goto l628
+					}
+					goto l627
+				l628:
+					position, tokenIndex = position627, tokenIndex627
+					if !_rules[ruleAnd]() {
+						goto l625
+					}
+				}
+			l627:
+				add(ruleDelimiter, position626)
+			}
+			return true
+		l625:
+			position, tokenIndex = position625, tokenIndex625
+			return false
+		},
+		/* 63 Bool <- <(('t' 'r' 'u' 'e') / ('f' 'a' 'l' 's' 'e'))> */
+		func() bool {
+			position629, tokenIndex629 := position, tokenIndex
+			{
+				position630 := position
+				{
+					position631, tokenIndex631 := position, tokenIndex
+					if buffer[position] != rune('t') {
+						goto l632
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l632
+					}
+					position++
+					if buffer[position] != rune('u') {
+						goto l632
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l632
+					}
+					position++
+					goto l631
+				l632:
+					position, tokenIndex = position631, tokenIndex631
+					if buffer[position] != rune('f') {
+						goto l629
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l629
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l629
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l629
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l629
+					}
+					position++
+				}
+			l631:
+				add(ruleBool, position630)
+			}
+			return true
+		l629:
+			position, tokenIndex = position629, tokenIndex629
+			return false
+		},
+		/* 64 FitParam <- <(('c' 'l' 'i' 'p') / ('s' 'c' 'a' 'l' 'e') / ('m' 'a' 'x') / ('c' 'r' 'o' 'p'))> */
+		func() bool {
+			position633, tokenIndex633 := position, tokenIndex
+			{
+				position634 := position
+				{
+					position635, tokenIndex635 := position, tokenIndex
+					if buffer[position] != rune('c') {
+						goto l636
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l636
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l636
+					}
+					position++
+					if buffer[position] != rune('p') {
+						goto l636
+					}
+					position++
+					goto l635
+				l636:
+					position, tokenIndex = position635, tokenIndex635
+					if buffer[position] != rune('s') {
+						goto l637
+					}
+					position++
+					if buffer[position] != rune('c') {
+						goto l637
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l637
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l637
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l637
+					}
+					position++
+					goto l635
+				l637:
+					position, tokenIndex = position635, tokenIndex635
+					if buffer[position] != rune('m') {
+						goto l638
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l638
+					}
+					position++
+					if buffer[position] != rune('x') {
+						goto l638
+					}
+					position++
+					goto l635
+				l638:
+					position, tokenIndex = position635, tokenIndex635
+					if buffer[position] != rune('c') {
+						goto l633
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l633
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l633
+					}
+					position++
+					if buffer[position] != rune('p') {
+						goto l633
+					}
+					position++
+				}
+			l635:
+				add(ruleFitParam, position634)
+			}
+			return true
+		l633:
+			position, tokenIndex = position633, tokenIndex633
+			return false
+		},
+		/* 65 ReverseParam <- <(('f' 'l' 'i' 'p') / ('f' 'l' 'o' 'p'))> */
+		func() bool {
+			position639, tokenIndex639 := position, tokenIndex
+			{
+				position640 := position
+				{
+					position641, tokenIndex641 := position, tokenIndex
+					if buffer[position] != rune('f') {
+						goto l642
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l642
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l642
+					}
+					position++
+					if buffer[position] != rune('p') {
+						goto l642
+					}
+					position++
+					goto l641
+				l642:
+					position, tokenIndex = position641, tokenIndex641
+					if buffer[position] != rune('f') {
+						goto l639
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l639
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l639
+					}
+					position++
+					if buffer[position] != rune('p') {
+						goto l639
+					}
+					position++
+				}
+			l641:
+				add(ruleReverseParam, position640)
+			}
+			return true
+		l639:
+			position, tokenIndex = position639, tokenIndex639
+			return false
+		},
+		/* 66 RedactModeParam <- <(('b' 'l' 'u' 'r') / ('f' 'i' 'l' 'l') / ('p' 'i' 'x' 'e' 'l' 'a' 't' 'e'))> */
+		func() bool {
+			position643, tokenIndex643 := position, tokenIndex
+			{
+				position644 := position
+				{
+					position645, tokenIndex645 := position, tokenIndex
+					if buffer[position] != rune('b') {
+						goto l646
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l646
+					}
+					position++
+					if buffer[position] != rune('u') {
+						goto l646
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l646
+					}
+					position++
+					goto l645
+				l646:
+					position, tokenIndex = position645, tokenIndex645
+					if buffer[position] != rune('f') {
+						goto l647
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l647
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l647
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l647
+					}
+					position++
+					goto l645
+				l647:
+					position, tokenIndex = position645, tokenIndex645
+					if buffer[position] != rune('p') {
+						goto l643
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l643
+					}
+					position++
+					if buffer[position] != rune('x') {
+						goto l643
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l643
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l643
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l643
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l643
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l643
+					}
+					position++
+				}
+			l645:
+				add(ruleRedactModeParam, position644)
+			}
+			return true
+		l643:
+			position, tokenIndex = position643, tokenIndex643
+			return false
+		},
+		/* 67 AlignParam <- <(('t' 'o' 'p') / ('m' 'i' 'd' 'd' 'l' 'e') / ('b' 'o' 't' 't' 'o' 'm') / ('l' 'e' 'f' 't') / ('c' 'e' 'n' 't' 'e' 'r') / ('r' 'i' 'g' 'h' 't'))> */
+		func() bool {
+			position648, tokenIndex648 := position, tokenIndex
+			{
+				position649 := position
+				{
+					position650, tokenIndex650 := position, tokenIndex
+					if buffer[position] != rune('t') {
+						goto l651
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l651
+					}
+					position++
+					if buffer[position] != rune('p') {
+						goto l651
+					}
+					position++
+					goto l650
+				l651:
+					position, tokenIndex = position650, tokenIndex650
+					if buffer[position] != rune('m') {
+						goto l652
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l652
+					}
+					position++
+					if buffer[position] != rune('d') {
+						goto l652
+					}
+					position++
+					if buffer[position] != rune('d') {
+						goto l652
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l652
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l652
+					}
+					position++
+					goto l650
+				l652:
+					position, tokenIndex = position650, tokenIndex650
+					if buffer[position] != rune('b') {
+						goto l653
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l653
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l653
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l653
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l653
+					}
+					position++
+					if buffer[position] != rune('m') {
+						goto l653
+					}
+					position++
+					goto l650
+				l653:
+					position, tokenIndex = position650, tokenIndex650
+					if buffer[position] != rune('l') {
+						goto l654
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l654
+					}
+					position++
+					if buffer[position] != rune('f') {
+						goto l654
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l654
+					}
+					position++
+					goto l650
+				l654:
+					position, tokenIndex = position650, tokenIndex650
+					if buffer[position] != rune('c') {
+						goto l655
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l655
+					}
+					position++
+					if buffer[position] != rune('n') {
+						goto l655
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l655
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l655
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l655
+					}
+					position++
+					goto l650
+				l655:
+					position, tokenIndex = position650, tokenIndex650
+					if buffer[position] != rune('r') {
+						goto l648
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l648
+					}
+					position++
+					if buffer[position] != rune('g') {
+						goto l648
+					}
+					position++
+					if buffer[position] != rune('h') {
+						goto l648
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l648
+					}
+					position++
+				}
+			l650:
+				add(ruleAlignParam, position649)
+			}
+			return true
+		l648:
+			position, tokenIndex = position648, tokenIndex648
+			return false
+		},
+		/* 68 TrimParam <- <(('a' 'u' 't' 'o') / ('c' 'o' 'l' 'o' 'r'))> */
+		func() bool {
+			position656, tokenIndex656 := position, tokenIndex
+			{
+				position657 := position
+				{
+					position658, tokenIndex658 := position, tokenIndex
+					if buffer[position] != rune('a') {
+						goto l659
+					}
+					position++
+					if buffer[position] != rune('u') {
+						goto l659
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l659
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l659
+					}
+					position++
+					goto l658
+				l659:
+					position, tokenIndex = position658, tokenIndex658
+					if buffer[position] != rune('c') {
+						goto l656
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l656
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l656
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l656
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l656
+					}
+					position++
+				}
+			l658:
+				add(ruleTrimParam, position657)
+			}
+			return true
+		l656:
+			position, tokenIndex = position656, tokenIndex656
+			return false
+		},
+		/* 69 FilterParam <- <(('n' 'e' 'a' 'r' 'e' 's' 't') / ('b' 'i' 'l' 'i' 'n' 'e' 'a' 'r') / ('b' 'i' 'c' 'u' 'b' 'i' 'c') / ('l' 'a' 'n' 'c' 'z' 'o' 's'))> */
+		func() bool {
+			position660, tokenIndex660 := position, tokenIndex
+			{
+				position661 := position
+				{
+					position662, tokenIndex662 := position, tokenIndex
+					if buffer[position] != rune('n') {
+						goto l663
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l663
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l663
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l663
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l663
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l663
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l663
+					}
+					position++
+					goto l662
+				l663:
+					position, tokenIndex = position662, tokenIndex662
+					if buffer[position] != rune('b') {
+						goto l664
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l664
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l664
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l664
+					}
+					position++
+					if buffer[position] != rune('n') {
+						goto l664
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l664
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l664
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l664
+					}
+					position++
+					goto l662
+				l664:
+					position, tokenIndex = position662, tokenIndex662
+					if buffer[position] != rune('b') {
+						goto l665
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l665
+					}
+					position++
+					if buffer[position] != rune('c') {
+						goto l665
+					}
+					position++
+					if buffer[position] != rune('u') {
+						goto l665
+					}
+					position++
+					if buffer[position] != rune('b') {
+						goto l665
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l665
+					}
+					position++
+					if buffer[position] != rune('c') {
+						goto l665
+					}
+					position++
+					goto l662
+				l665:
+					position, tokenIndex = position662, tokenIndex662
+					if buffer[position] != rune('l') {
+						goto l660
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l660
+					}
+					position++
+					if buffer[position] != rune('n') {
+						goto l660
+					}
+					position++
+					if buffer[position] != rune('c') {
+						goto l660
+					}
+					position++
+					if buffer[position] != rune('z') {
+						goto l660
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l660
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l660
+					}
+					position++
+				}
+			l662:
+				add(ruleFilterParam, position661)
+			}
+			return true
+		l660:
+			position, tokenIndex = position660, tokenIndex660
+			return false
+		},
+		/* 70 PaletteParam <- <(('j' 's' 'o' 'n') / ('c' 's' 's'))> */
+		func() bool {
+			position666, tokenIndex666 := position, tokenIndex
+			{
+				position667 := position
+				{
+					position668, tokenIndex668 := position, tokenIndex
+					if buffer[position] != rune('j') {
+						goto l669
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l669
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l669
+					}
+					position++
+					if buffer[position] != rune('n') {
+						goto l669
+					}
+					position++
+					goto l668
+				l669:
+					position, tokenIndex = position668, tokenIndex668
+					if buffer[position] != rune('c') {
+						goto l666
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l666
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l666
+					}
+					position++
+				}
+			l668:
+				add(rulePaletteParam, position667)
+			}
+			return true
+		l666:
+			position, tokenIndex = position666, tokenIndex666
+			return false
+		},
+		/* 71 LqipParam <- <(('b' 'l' 'u' 'r' 'h' 'a' 's' 'h') / ('b' 'a' 's' 'e' '6' '4'))> */
+		func() bool {
+			position670, tokenIndex670 := position, tokenIndex
+			{
+				position671 := position
+				{
+					position672, tokenIndex672 := position, tokenIndex
+					if buffer[position] != rune('b') {
+						goto l673
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l673
+					}
+					position++
+					if buffer[position] != rune('u') {
+						goto l673
+					}
+					position++
+					if buffer[position] != rune('r') {
+						goto l673
+					}
+					position++
+					if buffer[position] != rune('h') {
+						goto l673
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l673
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l673
+					}
+					position++
+					if buffer[position] != rune('h') {
+						goto l673
+					}
+					position++
+					goto l672
+				l673:
+					position, tokenIndex = position672, tokenIndex672
+					if buffer[position] != rune('b') {
+						goto l670
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l670
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l670
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l670
+					}
+					position++
+					if buffer[position] != rune('6') {
+						goto l670
+					}
+					position++
+					if buffer[position] != rune('4') {
+						goto l670
+					}
+					position++
+				}
+			l672:
+				add(ruleLqipParam, position671)
+			}
+			return true
+		l670:
+			position, tokenIndex = position670, tokenIndex670
+			return false
+		},
+		/* 72 PHashParam <- <('a' / 'd' / 'p')> */
+		func() bool {
+			position674, tokenIndex674 := position, tokenIndex
+			{
+				position675 := position
+				{
+					position676, tokenIndex676 := position, tokenIndex
+					if buffer[position] != rune('a') {
+						goto l677
+					}
+					position++
+					goto l676
+				l677:
+					position, tokenIndex = position676, tokenIndex676
+					if buffer[position] != rune('d') {
+						goto l678
+					}
+					position++
+					goto l676
+				l678:
+					position, tokenIndex = position676, tokenIndex676
+					if buffer[position] != rune('p') {
+						goto l674
+					}
+					position++
+				}
+			l676:
+				add(rulePHashParam, position675)
+			}
+			return true
+		l674:
+			position, tokenIndex = position674, tokenIndex674
+			return false
+		},
+		/* 73 Open <- <(Open_P / Open_B / Open_Box)> */
+		func() bool {
+			position679, tokenIndex679 := position, tokenIndex
+			{
+				position680 := position
+				{
+					position681, tokenIndex681 := position, tokenIndex
+					if !_rules[ruleOpen_P]() {
+						goto l682
+					}
+					goto l681
+				l682:
+					position, tokenIndex = position681, tokenIndex681
+					if !_rules[ruleOpen_B]() {
+						goto l683
+					}
+					goto l681
+				l683:
+					position, tokenIndex = position681, tokenIndex681
+					if !_rules[ruleOpen_Box]() {
+						goto l679
+					}
+				}
+			l681:
+				add(ruleOpen, position680)
+			}
+			return true
+		l679:
+			position, tokenIndex = position679, tokenIndex679
+			return false
+		},
+		/* 74 Close <- <(Close_P / Close_B / Close_Box)> */
+		func() bool {
+			position684, tokenIndex684 := position, tokenIndex
+			{
+				position685 := position
+				{
+					position686, tokenIndex686 := position, tokenIndex
+					if !_rules[ruleClose_P]() {
+						goto l687
+					}
+					goto l686
+				l687:
+					position, tokenIndex = position686, tokenIndex686
+					if !_rules[ruleClose_B]() {
+						goto l688
+					}
+					goto l686
+				l688:
+					position, tokenIndex = position686, tokenIndex686
+					if !_rules[ruleClose_Box]() {
+						goto l684
+					}
+				}
+			l686:
+				add(ruleClose, position685)
+			}
+			return true
+		l684:
+			position, tokenIndex = position684, tokenIndex684
+			return false
+		},
+		/* 75 Tuple_P <- <(Open Tuple_Set+ Close)> */
+		func() bool {
+			position689, tokenIndex689 := position, tokenIndex
+			{
+				position690 := position
+				if !_rules[ruleOpen]() {
+					goto l689
+				}
+				if !_rules[ruleTuple_Set]() {
+					goto l689
+				}
+			l691:
+				{
+					position692, tokenIndex692 := position, tokenIndex
+					if !_rules[ruleTuple_Set]() {
+						goto l692
+					}
+					goto l691
+				l692:
+					position, tokenIndex = position692, tokenIndex692
+				}
+				if !_rules[ruleClose]() {
+					goto l689
+				}
+				add(ruleTuple_P, position690)
+			}
+			return true
+		l689:
+			position, tokenIndex = position689, tokenIndex689
+			return false
+		},
+		/* 76 Tuple_Set <- <(Separater? (Tuple_Key_Width / Tuple_Key_Height / Tuple_Key_X / Tuple_Key_Y / Tuple_Key_Top / Tuple_Key_Right / Tuple_Key_Bottom / Tuple_Key_Left))> */
+		func() bool {
+			position693, tokenIndex693 := position, tokenIndex
+			{
+				position694 := position
+				{
+					position695, tokenIndex695 := position, tokenIndex
+					if !_rules[ruleSeparater]() {
+						goto l695
+					}
+					goto l696
+				l695:
+					position, tokenIndex = position695, tokenIndex695
+				}
+			l696:
+				{
+					position697, tokenIndex697 := position, tokenIndex
+					if !_rules[ruleTuple_Key_Width]() {
+						goto l698
+					}
+					goto l697
+				l698:
+					position, tokenIndex = position697, tokenIndex697
+					if !_rules[ruleTuple_Key_Height]() {
+						goto l699
+					}
+					goto l697
+				l699:
+					position, tokenIndex = position697, tokenIndex697
+					if !_rules[ruleTuple_Key_X]() {
+						goto l700
+					}
+					goto l697
+				l700:
+					position, tokenIndex = position697, tokenIndex697
+					if !_rules[ruleTuple_Key_Y]() {
+						goto l701
+					}
+					goto l697
+				l701:
+					position, tokenIndex = position697, tokenIndex697
+					if !_rules[ruleTuple_Key_Top]() {
+						goto l702
+					}
+					goto l697
+				l702:
+					position, tokenIndex = position697, tokenIndex697
+					if !_rules[ruleTuple_Key_Right]() {
+						goto l703
+					}
+					goto l697
+				l703:
+					position, tokenIndex = position697, tokenIndex697
+					if !_rules[ruleTuple_Key_Bottom]() {
+						goto l704
+					}
+					goto l697
+				l704:
+					position, tokenIndex = position697, tokenIndex697
+					if !_rules[ruleTuple_Key_Left]() {
+						goto l693
+					}
+				}
+			l697:
+				add(ruleTuple_Set, position694)
+			}
+			return true
+		l693:
+			position, tokenIndex = position693, tokenIndex693
+			return false
+		},
+		/* 77 Tuple_Key_Width <- <(Width_Key Separater? <(Digit / Dot)+> Action60)> */
+		func() bool {
+			position705, tokenIndex705 := position, tokenIndex
+			{
+				position706 := position
+				if !_rules[ruleWidth_Key]() {
+					goto l705
+				}
+				{
+					position707, tokenIndex707 := position, tokenIndex
+					if !_rules[ruleSeparater]() {
+						goto l707
+					}
+					goto l708
+				l707:
+					position, tokenIndex = position707, tokenIndex707
+				}
+			l708:
+				{
+					position709 := position
+					{
+						position712, tokenIndex712 := position, tokenIndex
+						if !_rules[ruleDigit]() {
+							goto l713
+						}
+						goto l712
+					l713:
+						position, tokenIndex = position712, tokenIndex712
+						if !_rules[ruleDot]() {
+							goto l705
+						}
+					}
+				l712:
+				l710:
+					{
+						position711, tokenIndex711 := position, tokenIndex
+						{
+							position714, tokenIndex714 := position, tokenIndex
+							if !_rules[ruleDigit]() {
+								goto l715
+							}
+							goto l714
+						l715:
+							position, tokenIndex = position714, tokenIndex714
+							if !_rules[ruleDot]() {
+								goto l711
+							}
+						}
+					l714:
+						goto l710
+					l711:
+						position, tokenIndex = position711, tokenIndex711
+					}
+					add(rulePegText, position709)
+				}
+				if !_rules[ruleAction60]() {
+					goto l705
+				}
+				add(ruleTuple_Key_Width, position706)
+			}
+			return true
+		l705:
+			position, tokenIndex = position705, tokenIndex705
+			return false
+		},
+		/* 78 Tuple_Key_Height <- <(Height_Key Separater? <(Digit / Dot)+> Action61)> */
+		func() bool {
+			position716, tokenIndex716 := position, tokenIndex
+			{
+				position717 := position
+				if !_rules[ruleHeight_Key]() {
+					goto l716
+				}
+				{
+					position718, tokenIndex718 := position, tokenIndex
+					if !_rules[ruleSeparater]() {
+						goto l718
+					}
+					goto l719
+				l718:
+					position, tokenIndex = position718, tokenIndex718
+				}
+			l719:
+				{
+					position720 := position
+					{
+						position723, tokenIndex723 := position, tokenIndex
+						if !_rules[ruleDigit]() {
+							goto l724
+						}
+						goto l723
+					l724:
+						position, tokenIndex = position723, tokenIndex723
+						if !_rules[ruleDot]() {
+							goto l716
+						}
+					}
+				l723:
+				l721:
+					{
+						position722, tokenIndex722 := position, tokenIndex
+						{
+							position725, tokenIndex725 := position, tokenIndex
+							if !_rules[ruleDigit]() {
+								goto l726
+							}
+							goto l725
+						l726:
+							position, tokenIndex = position725, tokenIndex725
+							if !_rules[ruleDot]() {
+								goto l722
+							}
+						}
+					l725:
+						goto l721
+					l722:
+						position, tokenIndex = position722, tokenIndex722
+					}
+					add(rulePegText, position720)
+				}
+				if !_rules[ruleAction61]() {
+					goto l716
+				}
+				add(ruleTuple_Key_Height, position717)
+			}
+			return true
+		l716:
+			position, tokenIndex = position716, tokenIndex716
+			return false
+		},
+		/* 79 Tuple_Key_X <- <('x' Separater? <(Digit / Dot)+> Action62)> */
+		func() bool {
+			position727, tokenIndex727 := position, tokenIndex
+			{
+				position728 := position
+				if buffer[position] != rune('x') {
+					goto l727
+				}
+				position++
+				{
+					position729, tokenIndex729 := position, tokenIndex
+					if !_rules[ruleSeparater]() {
+						goto l729
+					}
+					goto l730
+				l729:
+					position, tokenIndex = position729, tokenIndex729
+				}
+			l730:
+				{
+					position731 := position
+					{
+						position734, tokenIndex734 := position, tokenIndex
+						if !_rules[ruleDigit]() {
+							goto l735
+						}
+						goto l734
+					l735:
+						position, tokenIndex = position734, tokenIndex734
+						if !_rules[ruleDot]() {
+							goto l727
+						}
+					}
+				l734:
+				l732:
+					{
+						position733, tokenIndex733 := position, tokenIndex
+						{
+							position736, tokenIndex736 := position, tokenIndex
+							if !_rules[ruleDigit]() {
+								goto l737
+							}
+							goto l736
+						l737:
+							position, tokenIndex = position736, tokenIndex736
+							if !_rules[ruleDot]() {
+								goto l733
+							}
+						}
+					l736:
+						goto l732
+					l733:
+						position, tokenIndex = position733, tokenIndex733
+					}
+					add(rulePegText, position731)
+				}
+				if !_rules[ruleAction62]() {
+					goto l727
+				}
+				add(ruleTuple_Key_X, position728)
+			}
+			return true
+		l727:
+			position, tokenIndex = position727, tokenIndex727
+			return false
+		},
+		/* 80 Tuple_Key_Y <- <('y' Separater? <(Digit / Dot)+> Action63)> */
+		func() bool {
+			position738, tokenIndex738 := position, tokenIndex
+			{
+				position739 := position
+				if buffer[position] != rune('y') {
+					goto l738
+				}
+				position++
+				{
+					position740, tokenIndex740 := position, tokenIndex
+					if !_rules[ruleSeparater]() {
+						goto l740
+					}
+					goto l741
+				l740:
+					position, tokenIndex = position740, tokenIndex740
+				}
+			l741:
+				{
+					position742 := position
+					{
+						position745, tokenIndex745 := position, tokenIndex
+						if !_rules[ruleDigit]() {
+							goto l746
+						}
+						goto l745
+					l746:
+						position, tokenIndex = position745, tokenIndex745
+						if !_rules[ruleDot]() {
+							goto l738
+						}
+					}
+				l745:
+				l743:
+					{
+						position744, tokenIndex744 := position, tokenIndex
+						{
+							position747, tokenIndex747 := position, tokenIndex
+							if !_rules[ruleDigit]() {
+								goto l748
+							}
+							goto l747
+						l748:
+							position, tokenIndex = position747, tokenIndex747
+							if !_rules[ruleDot]() {
+								goto l744
+							}
+						}
+					l747:
+						goto l743
+					l744:
+						position, tokenIndex = position744, tokenIndex744
+					}
+					add(rulePegText, position742)
+				}
+				if !_rules[ruleAction63]() {
+					goto l738
+				}
+				add(ruleTuple_Key_Y, position739)
+			}
+			return true
+		l738:
+			position, tokenIndex = position738, tokenIndex738
+			return false
+		},
+		/* 81 Tuple_Key_Top <- <(Top_Key Separater? <(Digit / Dot)+> Action64)> */
+		func() bool {
+			position749, tokenIndex749 := position, tokenIndex
+			{
+				position750 := position
+				if !_rules[ruleTop_Key]() {
+					goto l749
+				}
+				{
+					position751, tokenIndex751 := position, tokenIndex
+					if !_rules[ruleSeparater]() {
+						goto l751
+					}
+					goto l752
+				l751:
+					position, tokenIndex = position751, tokenIndex751
+				}
+			l752:
+				{
+					position753 := position
+					{
+						position756, tokenIndex756 := position, tokenIndex
+						if !_rules[ruleDigit]() {
+							goto l757
+						}
+						goto l756
+					l757:
+						position, tokenIndex = position756, tokenIndex756
+						if !_rules[ruleDot]() {
+							goto l749
+						}
+					}
+				l756:
+				l754:
+					{
+						position755, tokenIndex755 := position, tokenIndex
+						{
+							position758, tokenIndex758 := position, tokenIndex
+							if !_rules[ruleDigit]() {
+								goto l759
+							}
+							goto l758
+						l759:
+							position, tokenIndex = position758, tokenIndex758
+							if !_rules[ruleDot]() {
+								goto l755
+							}
+						}
+					l758:
+						goto l754
+					l755:
+						position, tokenIndex = position755, tokenIndex755
+					}
+					add(rulePegText, position753)
+				}
+				if !_rules[ruleAction64]() {
+					goto l749
+				}
+				add(ruleTuple_Key_Top, position750)
+			}
+			return true
+		l749:
+			position, tokenIndex = position749, tokenIndex749
+			return false
+		},
+		/* 82 Tuple_Key_Right <- <(Right_Key Separater? <(Digit / Dot)+> Action65)> */
+		func() bool {
+			position760, tokenIndex760 := position, tokenIndex
+			{
+				position761 := position
+				if !_rules[ruleRight_Key]() {
+					goto l760
+				}
+				{
+					position762, tokenIndex762 := position, tokenIndex
+					if !_rules[ruleSeparater]() {
+						goto l762
+					}
+					goto l763
+				l762:
+					position, tokenIndex = position762, tokenIndex762
+				}
+			l763:
+				{
+					position764 := position
+					{
+						position767, tokenIndex767 := position, tokenIndex
+						if !_rules[ruleDigit]() {
+							goto l768
+						}
+						goto l767
+					l768:
+						position, tokenIndex = position767, tokenIndex767
+						if !_rules[ruleDot]() {
+							goto l760
+						}
+					}
+				l767:
+				l765:
+					{
+						position766, tokenIndex766 := position, tokenIndex
+						{
+							position769, tokenIndex769 := position, tokenIndex
+							if !_rules[ruleDigit]() {
+								goto l770
+							}
+							goto l769
+						l770:
+							position, tokenIndex = position769, tokenIndex769
+							if !_rules[ruleDot]() {
+								goto l766
+							}
+						}
+					l769:
+						goto l765
+					l766:
+						position, tokenIndex = position766, tokenIndex766
+					}
+					add(rulePegText, position764)
+				}
+				if !_rules[ruleAction65]() {
+					goto l760
+				}
+				add(ruleTuple_Key_Right, position761)
+			}
+			return true
+		l760:
+			position, tokenIndex = position760, tokenIndex760
+			return false
+		},
+		/* 83 Tuple_Key_Bottom <- <(Bottom_Key Separater? <(Digit / Dot)+> Action66)> */
+		func() bool {
+			position771, tokenIndex771 := position, tokenIndex
+			{
+				position772 := position
+				if !_rules[ruleBottom_Key]() {
+					goto l771
+				}
+				{
+					position773, tokenIndex773 := position, tokenIndex
+					if !_rules[ruleSeparater]() {
+						goto l773
+					}
+					goto l774
+				l773:
+					position, tokenIndex = position773, tokenIndex773
+				}
+			l774:
+				{
+					position775 := position
+					{
+						position778, tokenIndex778 := position, tokenIndex
+						if !_rules[ruleDigit]() {
+							goto l779
+						}
+						goto l778
+					l779:
+						position, tokenIndex = position778, tokenIndex778
+						if !_rules[ruleDot]() {
+							goto l771
+						}
+					}
+				l778:
+				l776:
+					{
+						position777, tokenIndex777 := position, tokenIndex
+						{
+							position780, tokenIndex780 := position, tokenIndex
+							if !_rules[ruleDigit]() {
+								goto l781
+							}
+							goto l780
+						l781:
+							position, tokenIndex = position780, tokenIndex780
+							if !_rules[ruleDot]() {
+								goto l777
+							}
+						}
+					l780:
+						goto l776
+					l777:
+						position, tokenIndex = position777, tokenIndex777
+					}
+					add(rulePegText, position775)
+				}
+				if !_rules[ruleAction66]() {
+					goto l771
+				}
+				add(ruleTuple_Key_Bottom, position772)
+			}
+			return true
+		l771:
+			position, tokenIndex = position771, tokenIndex771
+			return false
+		},
+		/* 84 Tuple_Key_Left <- <(Left_Key Separater? <(Digit / Dot)+> Action67)> */
+		func() bool {
+			position782, tokenIndex782 := position, tokenIndex
+			{
+				position783 := position
+				if !_rules[ruleLeft_Key]() {
+					goto l782
+				}
+				{
+					position784, tokenIndex784 := position, tokenIndex
+					if !_rules[ruleSeparater]() {
+						goto l784
+					}
+					goto l785
+				l784:
+					position, tokenIndex = position784, tokenIndex784
+				}
+			l785:
+				{
+					position786 := position
+					{
+						position789, tokenIndex789 := position, tokenIndex
+						if !_rules[ruleDigit]() {
+							goto l790
+						}
+						goto l789
+					l790:
+						position, tokenIndex = position789, tokenIndex789
+						if !_rules[ruleDot]() {
+							goto l782
+						}
+					}
+				l789:
+				l787:
+					{
+						position788, tokenIndex788 := position, tokenIndex
+						{
+							position791, tokenIndex791 := position, tokenIndex
+							if !_rules[ruleDigit]() {
+								goto l792
+							}
+							goto l791
+						l792:
+							position, tokenIndex = position791, tokenIndex791
+							if !_rules[ruleDot]() {
+								goto l788
+							}
+						}
+					l791:
+						goto l787
+					l788:
+						position, tokenIndex = position788, tokenIndex788
+					}
+					add(rulePegText, position786)
+				}
+				if !_rules[ruleAction67]() {
+					goto l782
+				}
+				add(ruleTuple_Key_Left, position783)
+			}
+			return true
+		l782:
+			position, tokenIndex = position782, tokenIndex782
+			return false
+		},
+		/* 85 Digit <- <[0-9]+> */
+		func() bool {
+			position793, tokenIndex793 := position, tokenIndex
+			{
+				position794 := position
+				if c := buffer[position]; c < rune('0') || c > rune('9') {
+					goto l793
+				}
+				position++
+			l795:
+				{
+					position796, tokenIndex796 := position, tokenIndex
+					if c := buffer[position]; c < rune('0') || c > rune('9') {
+						goto l796
+					}
+					position++
+					goto l795
+				l796:
+					position, tokenIndex = position796, tokenIndex796
+				}
+				add(ruleDigit, position794)
+			}
+			return true
+		l793:
+			position, tokenIndex = position793, tokenIndex793
+			return false
+		},
+		/* 86 Signed <- <(Haihun? (Digit / Dot)+)> */
+		func() bool {
+			position797, tokenIndex797 := position, tokenIndex
+			{
+				position798 := position
+				{
+					position799, tokenIndex799 := position, tokenIndex
+					if !_rules[ruleHaihun]() {
+						goto l799
+					}
+					goto l800
+				l799:
+					position, tokenIndex = position799, tokenIndex799
+				}
+			l800:
+				{
+					position803, tokenIndex803 := position, tokenIndex
+					if !_rules[ruleDigit]() {
+						goto l804
+					}
+					goto l803
+				l804:
+					position, tokenIndex = position803, tokenIndex803
+					if !_rules[ruleDot]() {
+						goto l797
+					}
+				}
+			l803:
+			l801:
+				{
+					position802, tokenIndex802 := position, tokenIndex
+					{
+						position805, tokenIndex805 := position, tokenIndex
+						if !_rules[ruleDigit]() {
+							goto l806
+						}
+						goto l805
+					l806:
+						position, tokenIndex = position805, tokenIndex805
+						if !_rules[ruleDot]() {
+							goto l802
+						}
+					}
+				l805:
+					goto l801
+				l802:
+					position, tokenIndex = position802, tokenIndex802
+				}
+				add(ruleSigned, position798)
+			}
+			return true
+		l797:
+			position, tokenIndex = position797, tokenIndex797
+			return false
+		},
+		/* 87 LowerCase <- <[a-z]+> */
+		func() bool {
+			position807, tokenIndex807 := position, tokenIndex
+			{
+				position808 := position
+				if c := buffer[position]; c < rune('a') || c > rune('z') {
+					goto l807
+				}
+				position++
+			l809:
+				{
+					position810, tokenIndex810 := position, tokenIndex
+					if c := buffer[position]; c < rune('a') || c > rune('z') {
+						goto l810
+					}
+					position++
+					goto l809
+				l810:
+					position, tokenIndex = position810, tokenIndex810
+				}
+				add(ruleLowerCase, position808)
+			}
+			return true
+		l807:
+			position, tokenIndex = position807, tokenIndex807
+			return false
+		},
+		/* 88 HexColor <- <([0-9] / [a-f] / [A-F])+> */
+		func() bool {
+			position811, tokenIndex811 := position, tokenIndex
+			{
+				position812 := position
+				{
+					position815, tokenIndex815 := position, tokenIndex
+					if c := buffer[position]; c < rune('0') || c > rune('9') {
+						goto l816
+					}
+					position++
+					goto l815
+				l816:
+					position, tokenIndex = position815, tokenIndex815
+					if c := buffer[position]; c < rune('a') || c > rune('f') {
+						goto l817
+					}
+					position++
+					goto l815
+				l817:
+					position, tokenIndex = position815, tokenIndex815
+					if c := buffer[position]; c < rune('A') || c > rune('F') {
+						goto l811
+					}
+					position++
+				}
+			l815:
+			l813:
+				{
+					position814, tokenIndex814 := position, tokenIndex
+					{
+						position818, tokenIndex818 := position, tokenIndex
+						if c := buffer[position]; c < rune('0') || c > rune('9') {
+							goto l819
+						}
+						position++
+						goto l818
+					l819:
+						position, tokenIndex = position818, tokenIndex818
+						if c := buffer[position]; c < rune('a') || c > rune('f') {
+							goto l820
+						}
+						position++
+						goto l818
+					l820:
+						position, tokenIndex = position818, tokenIndex818
+						if c := buffer[position]; c < rune('A') || c > rune('F') {
+							goto l814
+						}
+						position++
+					}
+				l818:
+					goto l813
+				l814:
+					position, tokenIndex = position814, tokenIndex814
+				}
+				add(ruleHexColor, position812)
+			}
+			return true
+		l811:
+			position, tokenIndex = position811, tokenIndex811
+			return false
+		},
+		/* 89 Path <- <([a-z] / [A-Z] / [0-9] / '_' / '/' / '.' / '-' / '~' / '%')+> */
+		func() bool {
+			position821, tokenIndex821 := position, tokenIndex
+			{
+				position822 := position
+				{
+					position825, tokenIndex825 := position, tokenIndex
+					if c := buffer[position]; c < rune('a') || c > rune('z') {
+						goto l826
+					}
+					position++
+					goto l825
+				l826:
+					position, tokenIndex = position825, tokenIndex825
+					if c := buffer[position]; c < rune('A') || c > rune('Z') {
+						goto l827
+					}
+					position++
+					goto l825
+				l827:
+					position, tokenIndex = position825, tokenIndex825
+					if c := buffer[position]; c < rune('0') || c > rune('9') {
+						goto l828
+					}
+					position++
+					goto l825
+				l828:
+					position, tokenIndex = position825, tokenIndex825
+					if buffer[position] != rune('_') {
+						goto l829
+					}
+					position++
+					goto l825
+				l829:
+					position, tokenIndex = position825, tokenIndex825
+					if buffer[position] != rune('/') {
+						goto l830
+					}
+					position++
+					goto l825
+				l830:
+					position, tokenIndex = position825, tokenIndex825
+					if buffer[position] != rune('.') {
+						goto l831
+					}
+					position++
+					goto l825
+				l831:
+					position, tokenIndex = position825, tokenIndex825
+					if buffer[position] != rune('-') {
+						goto l832
+					}
+					position++
+					goto l825
+				l832:
+					position, tokenIndex = position825, tokenIndex825
+					if buffer[position] != rune('~') {
+						goto l833
+					}
+					position++
+					goto l825
+				l833:
+					position, tokenIndex = position825, tokenIndex825
+					if buffer[position] != rune('%') {
+						goto l821
+					}
+					position++
+				}
+			l825:
+			l823:
+				{
+					position824, tokenIndex824 := position, tokenIndex
+					{
+						position834, tokenIndex834 := position, tokenIndex
+						if c := buffer[position]; c < rune('a') || c > rune('z') {
+							goto l835
+						}
+						position++
+						goto l834
+					l835:
+						position, tokenIndex = position834, tokenIndex834
+						if c := buffer[position]; c < rune('A') || c > rune('Z') {
+							goto l836
+						}
+						position++
+						goto l834
+					l836:
+						position, tokenIndex = position834, tokenIndex834
+						if c := buffer[position]; c < rune('0') || c > rune('9') {
+							goto l837
+						}
+						position++
+						goto l834
+					l837:
+						position, tokenIndex = position834, tokenIndex834
+						if buffer[position] != rune('_') {
+							goto l838
+						}
+						position++
+						goto l834
+					l838:
+						position, tokenIndex = position834, tokenIndex834
+						if buffer[position] != rune('/') {
+							goto l839
+						}
+						position++
+						goto l834
+					l839:
+						position, tokenIndex = position834, tokenIndex834
+						if buffer[position] != rune('.') {
+							goto l840
+						}
+						position++
+						goto l834
+					l840:
+						position, tokenIndex = position834, tokenIndex834
+						if buffer[position] != rune('-') {
+							goto l841
+						}
+						position++
+						goto l834
+					l841:
+						position, tokenIndex = position834, tokenIndex834
+						if buffer[position] != rune('~') {
+							goto l842
+						}
+						position++
+						goto l834
+					l842:
+						position, tokenIndex = position834, tokenIndex834
+						if buffer[position] != rune('%') {
+							goto l824
+						}
+						position++
+					}
+				l834:
+					goto l823
+				l824:
+					position, tokenIndex = position824, tokenIndex824
+				}
+				add(rulePath, position822)
+			}
+			return true
+		l821:
+			position, tokenIndex = position821, tokenIndex821
+			return false
+		},
+		/* 90 UrlText <- <([a-z] / [A-Z] / [0-9] / '_' / '.' / '-' / '~' / '!' / '*' / '\'' / '(' / ')' / '+' / ',' / ':' / ';' / '%')+> */
+		func() bool {
+			position843, tokenIndex843 := position, tokenIndex
+			{
+				position844 := position
+				{
+					position847, tokenIndex847 := position, tokenIndex
+					if c := buffer[position]; c < rune('a') || c > rune('z') {
+						goto l848
+					}
+					position++
+					goto l847
+				l848:
+					position, tokenIndex = position847, tokenIndex847
+					if c := buffer[position]; c < rune('A') || c > rune('Z') {
+						goto l849
+					}
+					position++
+					goto l847
+				l849:
+					position, tokenIndex = position847, tokenIndex847
+					if c := buffer[position]; c < rune('0') || c > rune('9') {
+						goto l850
+					}
+					position++
+					goto l847
+				l850:
+					position, tokenIndex = position847, tokenIndex847
+					if buffer[position] != rune('_') {
+						goto l851
+					}
+					position++
+					goto l847
+				l851:
+					position, tokenIndex = position847, tokenIndex847
+					if buffer[position] != rune('.') {
+						goto l852
+					}
+					position++
+					goto l847
+				l852:
+					position, tokenIndex = position847, tokenIndex847
+					if buffer[position] != rune('-') {
+						goto l853
+					}
+					position++
+					goto l847
+				l853:
+					position, tokenIndex = position847, tokenIndex847
+					if buffer[position] != rune('~') {
+						goto l854
+					}
+					position++
+					goto l847
+				l854:
+					position, tokenIndex = position847, tokenIndex847
+					if buffer[position] != rune('!') {
+						goto l855
+					}
+					position++
+					goto l847
+				l855:
+					position, tokenIndex = position847, tokenIndex847
+					if buffer[position] != rune('*') {
+						goto l856
+					}
+					position++
+					goto l847
+				l856:
+					position, tokenIndex = position847, tokenIndex847
+					if buffer[position] != rune('\'') {
+						goto l857
+					}
+					position++
+					goto l847
+				l857:
+					position, tokenIndex = position847, tokenIndex847
+					if buffer[position] != rune('(') {
+						goto l858
+					}
+					position++
+					goto l847
+				l858:
+					position, tokenIndex = position847, tokenIndex847
+					if buffer[position] != rune(')') {
+						goto l859
+					}
+					position++
+					goto l847
+				l859:
+					position, tokenIndex = position847, tokenIndex847
+					if buffer[position] != rune('+') {
+						goto l860
+					}
+					position++
+					goto l847
+				l860:
+					position, tokenIndex = position847, tokenIndex847
+					if buffer[position] != rune(',') {
+						goto l861
+					}
+					position++
+					goto l847
+				l861:
+					position, tokenIndex = position847, tokenIndex847
+					if buffer[position] != rune(':') {
+						goto l862
+					}
+					position++
+					goto l847
+				l862:
+					position, tokenIndex = position847, tokenIndex847
+					if buffer[position] != rune(';') {
+						goto l863
+					}
+					position++
+					goto l847
+				l863:
+					position, tokenIndex = position847, tokenIndex847
+					if buffer[position] != rune('%') {
+						goto l843
+					}
+					position++
+				}
+			l847:
+			l845:
+				{
+					position846, tokenIndex846 := position, tokenIndex
+					{
+						position864, tokenIndex864 := position, tokenIndex
+						if c := buffer[position]; c < rune('a') || c > rune('z') {
+							goto l865
+						}
+						position++
+						goto l864
+					l865:
+						position, tokenIndex = position864, tokenIndex864
+						if c := buffer[position]; c < rune('A') || c > rune('Z') {
+							goto l866
+						}
+						position++
+						goto l864
+					l866:
+						position, tokenIndex = position864, tokenIndex864
+						if c := buffer[position]; c < rune('0') || c > rune('9') {
+							goto l867
+						}
+						position++
+						goto l864
+					l867:
+						position, tokenIndex = position864, tokenIndex864
+						if buffer[position] != rune('_') {
+							goto l868
+						}
+						position++
+						goto l864
+					l868:
+						position, tokenIndex = position864, tokenIndex864
+						if buffer[position] != rune('.') {
+							goto l869
+						}
+						position++
+						goto l864
+					l869:
+						position, tokenIndex = position864, tokenIndex864
+						if buffer[position] != rune('-') {
+							goto l870
+						}
+						position++
+						goto l864
+					l870:
+						position, tokenIndex = position864, tokenIndex864
+						if buffer[position] != rune('~') {
+							goto l871
+						}
+						position++
+						goto l864
+					l871:
+						position, tokenIndex = position864, tokenIndex864
+						if buffer[position] != rune('!') {
+							goto l872
+						}
+						position++
+						goto l864
+					l872:
+						position, tokenIndex = position864, tokenIndex864
+						if buffer[position] != rune('*') {
+							goto l873
+						}
+						position++
+						goto l864
+					l873:
+						position, tokenIndex = position864, tokenIndex864
+						if buffer[position] != rune('\'') {
+							goto l874
+						}
+						position++
+						goto l864
+					l874:
+						position, tokenIndex = position864, tokenIndex864
+						if buffer[position] != rune('(') {
+							goto l875
+						}
+						position++
+						goto l864
+					l875:
+						position, tokenIndex = position864, tokenIndex864
+						if buffer[position] != rune(')') {
+							goto l876
+						}
+						position++
+						goto l864
+					l876:
+						position, tokenIndex = position864, tokenIndex864
+						if buffer[position] != rune('+') {
+							goto l877
+						}
+						position++
+						goto l864
+					l877:
+						position, tokenIndex = position864, tokenIndex864
+						if buffer[position] != rune(',') {
+							goto l878
+						}
+						position++
+						goto l864
+					l878:
+						position, tokenIndex = position864, tokenIndex864
+						if buffer[position] != rune(':') {
+							goto l879
+						}
+						position++
+						goto l864
+					l879:
+						position, tokenIndex = position864, tokenIndex864
+						if buffer[position] != rune(';') {
+							goto l880
+						}
+						position++
+						goto l864
+					l880:
+						position, tokenIndex = position864, tokenIndex864
+						if buffer[position] != rune('%') {
+							goto l846
+						}
+						position++
+					}
+				l864:
+					goto l845
+				l846:
+					position, tokenIndex = position846, tokenIndex846
+				}
+				add(ruleUrlText, position844)
+			}
+			return true
+		l843:
+			position, tokenIndex = position843, tokenIndex843
+			return false
+		},
+		/* 91 All <- <([a-z] / [A-Z] / [0-9] / '_' / '*' / '{' / '}' / '(' / ')' / ',' / ':' / ';' / '%' / '#' / '=' / '/' / '.' / '-' / '+')+> */
+		func() bool {
+			position881, tokenIndex881 := position, tokenIndex
+			{
+				position882 := position
+				{
+					position885, tokenIndex885 := position, tokenIndex
+					if c := buffer[position]; c < rune('a') || c > rune('z') {
+						goto l886
+					}
+					position++
+					goto l885
+				l886:
+					position, tokenIndex = position885, tokenIndex885
+					if c := buffer[position]; c < rune('A') || c > rune('Z') {
+						goto l887
+					}
+					position++
+					goto l885
+				l887:
+					position, tokenIndex = position885, tokenIndex885
+					if c := buffer[position]; c < rune('0') || c > rune('9') {
+						goto l888
+					}
+					position++
+					goto l885
+				l888:
+					position, tokenIndex = position885, tokenIndex885
+					if buffer[position] != rune('_') {
+						goto l889
+					}
+					position++
+					goto l885
+				l889:
+					position, tokenIndex = position885, tokenIndex885
+					if buffer[position] != rune('*') {
+						goto l890
+					}
+					position++
+					goto l885
+				l890:
+					position, tokenIndex = position885, tokenIndex885
+					if buffer[position] != rune('{') {
+						goto l891
+					}
+					position++
+					goto l885
+				l891:
+					position, tokenIndex = position885, tokenIndex885
+					if buffer[position] != rune('}') {
+						goto l892
+					}
+					position++
+					goto l885
+				l892:
+					position, tokenIndex = position885, tokenIndex885
+					if buffer[position] != rune('(') {
+						goto l893
+					}
+					position++
+					goto l885
+				l893:
+					position, tokenIndex = position885, tokenIndex885
+					if buffer[position] != rune(')') {
+						goto l894
+					}
+					position++
+					goto l885
+				l894:
+					position, tokenIndex = position885, tokenIndex885
+					if buffer[position] != rune(',') {
+						goto l895
+					}
+					position++
+					goto l885
+				l895:
+					position, tokenIndex = position885, tokenIndex885
+					if buffer[position] != rune(':') {
+						goto l896
+					}
+					position++
+					goto l885
+				l896:
+					position, tokenIndex = position885, tokenIndex885
+					if buffer[position] != rune(';') {
+						goto l897
+					}
+					position++
+					goto l885
+				l897:
+					position, tokenIndex = position885, tokenIndex885
+					if buffer[position] != rune('%') {
+						goto l898
+					}
+					position++
+					goto l885
+				l898:
+					position, tokenIndex = position885, tokenIndex885
+					if buffer[position] != rune('#') {
+						goto l899
+					}
+					position++
+					goto l885
+				l899:
+					position, tokenIndex = position885, tokenIndex885
+					if buffer[position] != rune('=') {
+						goto l900
+					}
+					position++
+					goto l885
+				l900:
+					position, tokenIndex = position885, tokenIndex885
+					if buffer[position] != rune('/') {
+						goto l901
+					}
+					position++
+					goto l885
+				l901:
+					position, tokenIndex = position885, tokenIndex885
+					if buffer[position] != rune('.') {
+						goto l902
+					}
+					position++
+					goto l885
+				l902:
+					position, tokenIndex = position885, tokenIndex885
+					if buffer[position] != rune('-') {
+						goto l903
+					}
+					position++
+					goto l885
+				l903:
+					position, tokenIndex = position885, tokenIndex885
+					if buffer[position] != rune('+') {
+						goto l881
+					}
+					position++
+				}
+			l885:
+			l883:
+				{
+					position884, tokenIndex884 := position, tokenIndex
+					{
+						position904, tokenIndex904 := position, tokenIndex
+						if c := buffer[position]; c < rune('a') || c > rune('z') {
+							goto l905
+						}
+						position++
+						goto l904
+					l905:
+						position, tokenIndex = position904, tokenIndex904
+						if c := buffer[position]; c < rune('A') || c > rune('Z') {
+							goto l906
+						}
+						position++
+						goto l904
+					l906:
+						position, tokenIndex = position904, tokenIndex904
+						if c := buffer[position]; c < rune('0') || c > rune('9') {
+							goto l907
+						}
+						position++
+						goto l904
+					l907:
+						position, tokenIndex = position904, tokenIndex904
+						if buffer[position] != rune('_') {
+							goto l908
+						}
+						position++
+						goto l904
+					l908:
+						position, tokenIndex = position904, tokenIndex904
+						if buffer[position] != rune('*') {
+							goto l909
+						}
+						position++
+						goto l904
+					l909:
+						position, tokenIndex = position904, tokenIndex904
+						if buffer[position] != rune('{') {
+							goto l910
+						}
+						position++
+						goto l904
+					l910:
+						position, tokenIndex = position904, tokenIndex904
+						if buffer[position] != rune('}') {
+							goto l911
+						}
+						position++
+						goto l904
+					l911:
+						position, tokenIndex = position904, tokenIndex904
+						if buffer[position] != rune('(') {
+							goto l912
+						}
+						position++
+						goto l904
+					l912:
+						position, tokenIndex = position904, tokenIndex904
+						if buffer[position] != rune(')') {
+							goto l913
+						}
+						position++
+						goto l904
+					l913:
+						position, tokenIndex = position904, tokenIndex904
+						if buffer[position] != rune(',') {
+							goto l914
+						}
+						position++
+						goto l904
+					l914:
+						position, tokenIndex = position904, tokenIndex904
+						if buffer[position] != rune(':') {
+							goto l915
+						}
+						position++
+						goto l904
+					l915:
+						position, tokenIndex = position904, tokenIndex904
+						if buffer[position] != rune(';') {
+							goto l916
+						}
+						position++
+						goto l904
+					l916:
+						position, tokenIndex = position904, tokenIndex904
+						if buffer[position] != rune('%') {
+							goto l917
+						}
+						position++
+						goto l904
+					l917:
+						position, tokenIndex = position904, tokenIndex904
+						if buffer[position] != rune('#') {
+							goto l918
+						}
+						position++
+						goto l904
+					l918:
+						position, tokenIndex = position904, tokenIndex904
+						if buffer[position] != rune('=') {
+							goto l919
+						}
+						position++
+						goto l904
+					l919:
+						position, tokenIndex = position904, tokenIndex904
+						if buffer[position] != rune('/') {
+							goto l920
+						}
+						position++
+						goto l904
+					l920:
+						position, tokenIndex = position904, tokenIndex904
+						if buffer[position] != rune('.') {
+							goto l921
+						}
+						position++
+						goto l904
+					l921:
+						position, tokenIndex = position904, tokenIndex904
+						if buffer[position] != rune('-') {
+							goto l922
+						}
+						position++
+						goto l904
+					l922:
+						position, tokenIndex = position904, tokenIndex904
+						if buffer[position] != rune('+') {
+							goto l884
+						}
+						position++
+					}
+				l904:
+					goto l883
+				l884:
+					position, tokenIndex = position884, tokenIndex884
+				}
+				add(ruleAll, position882)
+			}
+			return true
+		l881:
+			position, tokenIndex = position881, tokenIndex881
+			return false
+		},
+		/* 92 Format_Key <- <('f' 'o' 'r' 'm' 'a' 't')> */
+		func() bool {
+			position923, tokenIndex923 := position, tokenIndex
+			{
+				position924 := position
+				if buffer[position] != rune('f') {
+					goto l923
+				}
+				position++
+				if buffer[position] != rune('o') {
+					goto l923
+				}
+				position++
+				if buffer[position] != rune('r') {
+					goto l923
+				}
+				position++
+				if buffer[position] != rune('m') {
+					goto l923
+				}
+				position++
+				if buffer[position] != rune('a') {
+					goto l923
+				}
+				position++
+				if buffer[position] != rune('t') {
+					goto l923
+				}
+				position++
+				add(ruleFormat_Key, position924)
+			}
+			return true
+		l923:
+			position, tokenIndex = position923, tokenIndex923
+			return false
+		},
+		/* 93 Progressive_Key <- <('p' 'r' 'o' 'g' 'r' 'e' 's' 's' 'i' 'v' 'e')> */
+		func() bool {
+			position925, tokenIndex925 := position, tokenIndex
+			{
+				position926 := position
+				if buffer[position] != rune('p') {
+					goto l925
+				}
+				position++
+				if buffer[position] != rune('r') {
+					goto l925
+				}
+				position++
+				if buffer[position] != rune('o') {
+					goto l925
+				}
+				position++
+				if buffer[position] != rune('g') {
+					goto l925
+				}
+				position++
+				if buffer[position] != rune('r') {
+					goto l925
+				}
+				position++
+				if buffer[position] != rune('e') {
+					goto l925
+				}
+				position++
+				if buffer[position] != rune('s') {
+					goto l925
+				}
+				position++
+				if buffer[position] != rune('s') {
+					goto l925
+				}
+				position++
+				if buffer[position] != rune('i') {
+					goto l925
+				}
+				position++
+				if buffer[position] != rune('v') {
+					goto l925
+				}
+				position++
+				if buffer[position] != rune('e') {
+					goto l925
+				}
+				position++
+				add(ruleProgressive_Key, position926)
+			}
+			return true
+		l925:
+			position, tokenIndex = position925, tokenIndex925
+			return false
+		},
+		/* 94 Width_Key <- <(('w' 'i' 'd' 't' 'h') / 'w')> */
+		func() bool {
+			position927, tokenIndex927 := position, tokenIndex
+			{
+				position928 := position
+				{
+					position929, tokenIndex929 := position, tokenIndex
+					if buffer[position] != rune('w') {
+						goto l930
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l930
+					}
+					position++
+					if buffer[position] != rune('d') {
+						goto l930
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l930
+					}
+					position++
+					if buffer[position] != rune('h') {
+						goto l930
+					}
+					position++
+					goto l929
+				l930:
+					position, tokenIndex = position929, tokenIndex929
+					if buffer[position] != rune('w') {
+						goto l927
+					}
+					position++
+				}
+			l929:
+				add(ruleWidth_Key, position928)
+			}
+			return true
+		l927:
+			position, tokenIndex = position927, tokenIndex927
+			return false
+		},
+		/* 95 Height_Key <- <(('h' 'e' 'i' 'g' 'h' 't') / 'h')> */
+		func() bool {
+			position931, tokenIndex931 := position, tokenIndex
+			{
+				position932 := position
+				{
+					position933, tokenIndex933 := position, tokenIndex
+					if buffer[position] != rune('h') {
+						goto l934
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l934
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l934
+					}
+					position++
+					if buffer[position] != rune('g') {
+						goto l934
+					}
+					position++
+					if buffer[position] != rune('h') {
+						goto l934
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l934
+					}
+					position++
+					goto l933
+				l934:
+					position, tokenIndex = position933, tokenIndex933
+					if buffer[position] != rune('h') {
+						goto l931
+					}
+					position++
+				}
+			l933:
+				add(ruleHeight_Key, position932)
+			}
+			return true
+		l931:
+			position, tokenIndex = position931, tokenIndex931
+			return false
+		},
+		/* 96 Fit_Key <- <('f' 'i' 't')> */
+		func() bool {
+			position935, tokenIndex935 := position, tokenIndex
+			{
+				position936 := position
+				if buffer[position] != rune('f') {
+					goto l935
+				}
+				position++
+				if buffer[position] != rune('i') {
+					goto l935
+				}
+				position++
+				if buffer[position] != rune('t') {
+					goto l935
+				}
+				position++
+				add(ruleFit_Key, position936)
+			}
+			return true
+		l935:
+			position, tokenIndex = position935, tokenIndex935
+			return false
+		},
+		/* 97 Scale_Key <- <('s' 'c' 'a' 'l' 'e')> */
+		func() bool {
+			position937, tokenIndex937 := position, tokenIndex
+			{
+				position938 := position
+				if buffer[position] != rune('s') {
+					goto l937
+				}
+				position++
+				if buffer[position] != rune('c') {
+					goto l937
+				}
+				position++
+				if buffer[position] != rune('a') {
+					goto l937
+				}
+				position++
+				if buffer[position] != rune('l') {
+					goto l937
+				}
+				position++
+				if buffer[position] != rune('e') {
+					goto l937
+				}
+				position++
+				add(ruleScale_Key, position938)
+			}
+			return true
+		l937:
+			position, tokenIndex = position937, tokenIndex937
+			return false
+		},
+		/* 98 Reverse_Key <- <('r' 'e' 'v' 'e' 'r' 's' 'e')> */
+		func() bool {
+			position939, tokenIndex939 := position, tokenIndex
+			{
+				position940 := position
+				if buffer[position] != rune('r') {
+					goto l939
+				}
+				position++
+				if buffer[position] != rune('e') {
+					goto l939
+				}
+				position++
+				if buffer[position] != rune('v') {
+					goto l939
+				}
+				position++
+				if buffer[position] != rune('e') {
+					goto l939
+				}
+				position++
+				if buffer[position] != rune('r') {
+					goto l939
+				}
+				position++
+				if buffer[position] != rune('s') {
+					goto l939
+				}
+				position++
+				if buffer[position] != rune('e') {
+					goto l939
+				}
+				position++
+				add(ruleReverse_Key, position940)
+			}
+			return true
+		l939:
+			position, tokenIndex = position939, tokenIndex939
+			return false
+		},
+		/* 99 Crop_Key <- <('c' 'r' 'o' 'p')> */
+		func() bool {
+			position941, tokenIndex941 := position, tokenIndex
+			{
+				position942 := position
+				if buffer[position] != rune('c') {
+					goto l941
+				}
+				position++
+				if buffer[position] != rune('r') {
+					goto l941
+				}
+				position++
+				if buffer[position] != rune('o') {
+					goto l941
+				}
+				position++
+				if buffer[position] != rune('p') {
+					goto l941
+				}
+				position++
+				add(ruleCrop_Key, position942)
+			}
+			return true
+		l941:
+			position, tokenIndex = position941, tokenIndex941
+			return false
+		},
+		/* 100 Quality_Key <- <(('q' 'u' 'a' 'l' 'i' 't' 'y') / 'q')> */
+		func() bool {
+			position943, tokenIndex943 := position, tokenIndex
+			{
+				position944 := position
+				{
+					position945, tokenIndex945 := position, tokenIndex
+					if buffer[position] != rune('q') {
+						goto l946
+					}
+					position++
+					if buffer[position] != rune('u') {
+						goto l946
+					}
+					position++
+					if buffer[position] != rune('a') {
+						goto l946
+					}
+					position++
+					if buffer[position] != rune('l') {
+						goto l946
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l946
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l946
+					}
+					position++
+					if buffer[position] != rune('y') {
+						goto l946
+					}
+					position++
+					goto l945
+				l946:
+					position, tokenIndex = position945, tokenIndex945
+					if buffer[position] != rune('q') {
+						goto l943
+					}
+					position++
+				}
+			l945:
+				add(ruleQuality_Key, position944)
+			}
+			return true
+		l943:
+			position, tokenIndex = position943, tokenIndex943
+			return false
+		},
+		/* 101 Exif_Key <- <('e' 'x' 'i' 'f')> */
+		func() bool {
+			position947, tokenIndex947 := position, tokenIndex
+			{
+				position948 := position
+				if buffer[position] != rune('e') {
+					goto l947
+				}
+				position++
+				if buffer[position] != rune('x') {
+					goto l947
+				}
+				position++
+				if buffer[position] != rune('i') {
+					goto l947
+				}
+				position++
+				if buffer[position] != rune('f') {
+					goto l947
+				}
+				position++
+				add(ruleExif_Key, position948)
+			}
+			return true
+		l947:
+			position, tokenIndex = position947, tokenIndex947
+			return false
+		},
+		/* 102 StripGps_Key <- <('s' 't' 'r' 'i' 'p' '-' 'g' 'p' 's')> */
+		func() bool {
+			position949, tokenIndex949 := position, tokenIndex
+			{
+				position950 := position
+				if buffer[position] != rune('s') {
+					goto l949
+				}
+				position++
+				if buffer[position] != rune('t') {
+					goto l949
+				}
+				position++
+				if buffer[position] != rune('r') {
+					goto l949
+				}
+				position++
+				if buffer[position] != rune('i') {
+					goto l949
+				}
+				position++
+				if buffer[position] != rune('p') {
+					goto l949
+				}
+				position++
+				if buffer[position] != rune('-') {
+					goto l949
+				}
+				position++
+				if buffer[position] != rune('g') {
+					goto l949
+				}
+				position++
+				if buffer[position] != rune('p') {
+					goto l949
+				}
+				position++
+				if buffer[position] != rune('s') {
+					goto l949
+				}
+				position++
+				add(ruleStripGps_Key, position950)
+			}
+			return true
+		l949:
+			position, tokenIndex = position949, tokenIndex949
+			return false
+		},
+		/* 103 AspectRatio_Key <- <('a' 'r')> */
+		func() bool {
+			position951, tokenIndex951 := position, tokenIndex
+			{
+				position952 := position
+				if buffer[position] != rune('a') {
+					goto l951
+				}
+				position++
+				if buffer[position] != rune('r') {
+					goto l951
+				}
+				position++
+				add(ruleAspectRatio_Key, position952)
+			}
+			return true
+		l951:
+			position, tokenIndex = position951, tokenIndex951
+			return false
+		},
+		/* 104 Background_Key <- <('b' 'g')> */
+		func() bool {
+			position953, tokenIndex953 := position, tokenIndex
+			{
+				position954 := position
+				if buffer[position] != rune('b') {
+					goto l953
+				}
+				position++
+				if buffer[position] != rune('g') {
+					goto l953
+				}
+				position++
+				add(ruleBackground_Key, position954)
+			}
+			return true
+		l953:
+			position, tokenIndex = position953, tokenIndex953
+			return false
+		},
+		/* 105 Dpr_Key <- <('d' 'p' 'r')> */
+		func() bool {
+			position955, tokenIndex955 := position, tokenIndex
+			{
+				position956 := position
+				if buffer[position] != rune('d') {
+					goto l955
+				}
+				position++
+				if buffer[position] != rune('p') {
+					goto l955
+				}
+				position++
+				if buffer[position] != rune('r') {
+					goto l955
+				}
+				position++
+				add(ruleDpr_Key, position956)
+			}
+			return true
+		l955:
+			position, tokenIndex = position955, tokenIndex955
+			return false
+		},
+		/* 106 Brightness_Key <- <('b' 'r' 'i')> */
+		func() bool {
+			position957, tokenIndex957 := position, tokenIndex
+			{
+				position958 := position
+				if buffer[position] != rune('b') {
+					goto l957
+				}
+				position++
+				if buffer[position] != rune('r') {
+					goto l957
+				}
+				position++
+				if buffer[position] != rune('i') {
+					goto l957
+				}
+				position++
+				add(ruleBrightness_Key, position958)
+			}
+			return true
+		l957:
+			position, tokenIndex = position957, tokenIndex957
+			return false
+		},
+		/* 107 Contrast_Key <- <('c' 'o' 'n')> */
+		func() bool {
+			position959, tokenIndex959 := position, tokenIndex
+			{
+				position960 := position
+				if buffer[position] != rune('c') {
+					goto l959
+				}
+				position++
+				if buffer[position] != rune('o') {
+					goto l959
+				}
+				position++
+				if buffer[position] != rune('n') {
+					goto l959
+				}
+				position++
+				add(ruleContrast_Key, position960)
+			}
+			return true
+		l959:
+			position, tokenIndex = position959, tokenIndex959
+			return false
+		},
+		/* 108 Saturation_Key <- <('s' 'a' 't')> */
+		func() bool {
+			position961, tokenIndex961 := position, tokenIndex
+			{
+				position962 := position
+				if buffer[position] != rune('s') {
+					goto l961
+				}
+				position++
+				if buffer[position] != rune('a') {
+					goto l961
+				}
+				position++
+				if buffer[position] != rune('t') {
+					goto l961
+				}
+				position++
+				add(ruleSaturation_Key, position962)
+			}
+			return true
+		l961:
+			position, tokenIndex = position961, tokenIndex961
+			return false
+		},
+		/* 109 Gamma_Key <- <('g' 'a' 'm')> */
+		func() bool {
+			position963, tokenIndex963 := position, tokenIndex
+			{
+				position964 := position
+				if buffer[position] != rune('g') {
+					goto l963
+				}
+				position++
+				if buffer[position] != rune('a') {
+					goto l963
+				}
+				position++
+				if buffer[position] != rune('m') {
+					goto l963
+				}
+				position++
+				add(ruleGamma_Key, position964)
+			}
+			return true
+		l963:
+			position, tokenIndex = position963, tokenIndex963
+			return false
+		},
+		/* 110 Hue_Key <- <('h' 'u' 'e')> */
+		func() bool {
+			position965, tokenIndex965 := position, tokenIndex
+			{
+				position966 := position
+				if buffer[position] != rune('h') {
+					goto l965
+				}
+				position++
+				if buffer[position] != rune('u') {
+					goto l965
+				}
+				position++
+				if buffer[position] != rune('e') {
+					goto l965
+				}
+				position++
+				add(ruleHue_Key, position966)
+			}
+			return true
+		l965:
+			position, tokenIndex = position965, tokenIndex965
+			return false
+		},
+		/* 111 Mono_Key <- <('m' 'o' 'n' 'o')> */
+		func() bool {
+			position967, tokenIndex967 := position, tokenIndex
+			{
+				position968 := position
+				if buffer[position] != rune('m') {
+					goto l967
+				}
+				position++
+				if buffer[position] != rune('o') {
+					goto l967
+				}
+				position++
+				if buffer[position] != rune('n') {
+					goto l967
+				}
+				position++
+				if buffer[position] != rune('o') {
+					goto l967
+				}
+				position++
+				add(ruleMono_Key, position968)
+			}
+			return true
+		l967:
+			position, tokenIndex = position967, tokenIndex967
+			return false
+		},
+		/* 112 Sepia_Key <- <('s' 'e' 'p' 'i' 'a')> */
+		func() bool {
+			position969, tokenIndex969 := position, tokenIndex
+			{
+				position970 := position
+				if buffer[position] != rune('s') {
+					goto l969
+				}
+				position++
+				if buffer[position] != rune('e') {
+					goto l969
+				}
+				position++
+				if buffer[position] != rune('p') {
+					goto l969
+				}
+				position++
+				if buffer[position] != rune('i') {
+					goto l969
+				}
+				position++
+				if buffer[position] != rune('a') {
+					goto l969
+				}
+				position++
+				add(ruleSepia_Key, position970)
+			}
+			return true
+		l969:
+			position, tokenIndex = position969, tokenIndex969
+			return false
+		},
+		/* 113 Invert_Key <- <('i' 'n' 'v' 'e' 'r' 't')> */
+		func() bool {
+			position971, tokenIndex971 := position, tokenIndex
+			{
+				position972 := position
+				if buffer[position] != rune('i') {
+					goto l971
+				}
+				position++
+				if buffer[position] != rune('n') {
+					goto l971
+				}
+				position++
+				if buffer[position] != rune('v') {
+					goto l971
+				}
+				position++
+				if buffer[position] != rune('e') {
+					goto l971
+				}
+				position++
+				if buffer[position] != rune('r') {
+					goto l971
+				}
+				position++
+				if buffer[position] != rune('t') {
+					goto l971
+				}
+				position++
+				add(ruleInvert_Key, position972)
+			}
+			return true
+		l971:
+			position, tokenIndex = position971, tokenIndex971
+			return false
+		},
+		/* 114 Duotone_Key <- <('d' 'u' 'o' 't' 'o' 'n' 'e')> */
+		func() bool {
+			position973, tokenIndex973 := position, tokenIndex
+			{
+				position974 := position
+				if buffer[position] != rune('d') {
+					goto l973
+				}
+				position++
+				if buffer[position] != rune('u') {
+					goto l973
+				}
+				position++
+				if buffer[position] != rune('o') {
+					goto l973
+				}
+				position++
+				if buffer[position] != rune('t') {
+					goto l973
+				}
+				position++
+				if buffer[position] != rune('o') {
+					goto l973
+				}
+				position++
+				if buffer[position] != rune('n') {
+					goto l973
+				}
+				position++
+				if buffer[position] != rune('e') {
+					goto l973
+				}
+				position++
+				add(ruleDuotone_Key, position974)
+			}
+			return true
+		l973:
+			position, tokenIndex = position973, tokenIndex973
+			return false
+		},
+		/* 115 Blur_Key <- <('b' 'l' 'u' 'r')> */
+		func() bool {
+			position975, tokenIndex975 := position, tokenIndex
+			{
+				position976 := position
+				if buffer[position] != rune('b') {
+					goto l975
+				}
+				position++
+				if buffer[position] != rune('l') {
+					goto l975
+				}
+				position++
+				if buffer[position] != rune('u') {
+					goto l975
+				}
+				position++
+				if buffer[position] != rune('r') {
+					goto l975
+				}
+				position++
+				add(ruleBlur_Key, position976)
+			}
+			return true
+		l975:
+			position, tokenIndex = position975, tokenIndex975
+			return false
+		},
+		/* 116 Sharpen_Key <- <('s' 'h' 'a' 'r' 'p')> */
+		func() bool {
+			position977, tokenIndex977 := position, tokenIndex
+			{
+				position978 := position
+				if buffer[position] != rune('s') {
+					goto l977
+				}
+				position++
+				if buffer[position] != rune('h') {
+					goto l977
+				}
+				position++
+				if buffer[position] != rune('a') {
+					goto l977
+				}
+				position++
+				if buffer[position] != rune('r') {
+					goto l977
+				}
+				position++
+				if buffer[position] != rune('p') {
+					goto l977
+				}
+				position++
+				add(ruleSharpen_Key, position978)
+			}
+			return true
+		l977:
+			position, tokenIndex = position977, tokenIndex977
+			return false
+		},
+		/* 117 Pixelate_Key <- <('p' 'x')> */
+		func() bool {
+			position979, tokenIndex979 := position, tokenIndex
+			{
+				position980 := position
+				if buffer[position] != rune('p') {
+					goto l979
+				}
+				position++
+				if buffer[position] != rune('x') {
+					goto l979
+				}
+				position++
+				add(rulePixelate_Key, position980)
+			}
+			return true
+		l979:
+			position, tokenIndex = position979, tokenIndex979
+			return false
+		},
+		/* 118 Redact_Key <- <('r' 'e' 'd' 'a' 'c' 't')> */
+		func() bool {
+			position981, tokenIndex981 := position, tokenIndex
+			{
+				position982 := position
+				if buffer[position] != rune('r') {
+					goto l981
+				}
+				position++
+				if buffer[position] != rune('e') {
+					goto l981
+				}
+				position++
+				if buffer[position] != rune('d') {
+					goto l981
+				}
+				position++
+				if buffer[position] != rune('a') {
+					goto l981
+				}
+				position++
+				if buffer[position] != rune('c') {
+					goto l981
+				}
+				position++
+				if buffer[position] != rune('t') {
+					goto l981
+				}
+				position++
+				add(ruleRedact_Key, position982)
+			}
+			return true
+		l981:
+			position, tokenIndex = position981, tokenIndex981
+			return false
+		},
+		/* 119 RedactMode_Key <- <('r' 'e' 'd' 'a' 'c' 't' '-' 'm' 'o' 'd' 'e')> */
+		func() bool {
+			position983, tokenIndex983 := position, tokenIndex
+			{
+				position984 := position
+				if buffer[position] != rune('r') {
+					goto l983
+				}
+				position++
+				if buffer[position] != rune('e') {
+					goto l983
+				}
+				position++
+				if buffer[position] != rune('d') {
+					goto l983
+				}
+				position++
+				if buffer[position] != rune('a') {
+					goto l983
+				}
+				position++
+				if buffer[position] != rune('c') {
+					goto l983
+				}
+				position++
+				if buffer[position] != rune('t') {
+					goto l983
+				}
+				position++
+				if buffer[position] != rune('-') {
+					goto l983
+				}
+				position++
+				if buffer[position] != rune('m') {
+					goto l983
+				}
+				position++
+				if buffer[position] != rune('o') {
+					goto l983
+				}
+				position++
+				if buffer[position] != rune('d') {
+					goto l983
+				}
+				position++
+				if buffer[position] != rune('e') {
+					goto l983
+				}
+				position++
+				add(ruleRedactMode_Key, position984)
+			}
+			return true
+		l983:
+			position, tokenIndex = position983, tokenIndex983
+			return false
+		},
+		/* 120 Pad_Key <- <('p' 'a' 'd')> */
+		func() bool {
+			position985, tokenIndex985 := position, tokenIndex
+			{
+				position986 := position
+				if buffer[position] != rune('p') {
+					goto l985
+				}
+				position++
+				if buffer[position] != rune('a') {
+					goto l985
+				}
+				position++
+				if buffer[position] != rune('d') {
+					goto l985
+				}
+				position++
+				add(rulePad_Key, position986)
+			}
+			return true
+		l985:
+			position, tokenIndex = position985, tokenIndex985
+			return false
+		},
+		/* 121 Border_Key <- <('b' 'o' 'r' 'd' 'e' 'r')> */
+		func() bool {
+			position987, tokenIndex987 := position, tokenIndex
+			{
+				position988 := position
+				if buffer[position] != rune('b') {
+					goto l987
+				}
+				position++
+				if buffer[position] != rune('o') {
+					goto l987
+				}
+				position++
+				if buffer[position] != rune('r') {
+					goto l987
+				}
+				position++
+				if buffer[position] != rune('d') {
+					goto l987
+				}
+				position++
+				if buffer[position] != rune('e') {
+					goto l987
+				}
+				position++
+				if buffer[position] != rune('r') {
+					goto l987
+				}
+				position++
+				add(ruleBorder_Key, position988)
+			}
+			return true
+		l987:
+			position, tokenIndex = position987, tokenIndex987
+			return false
+		},
+		/* 122 Mark_Key <- <('m' 'a' 'r' 'k')> */
+		func() bool {
+			position989, tokenIndex989 := position, tokenIndex
+			{
+				position990 := position
+				if buffer[position] != rune('m') {
+					goto l989
+				}
+				position++
+				if buffer[position] != rune('a') {
+					goto l989
+				}
+				position++
+				if buffer[position] != rune('r') {
+					goto l989
+				}
+				position++
+				if buffer[position] != rune('k') {
+					goto l989
+				}
+				position++
+				add(ruleMark_Key, position990)
+			}
+			return true
+		l989:
+			position, tokenIndex = position989, tokenIndex989
+			return false
+		},
+		/* 123 MarkWidth_Key <- <('m' 'a' 'r' 'k' '-' 'w')> */
+		func() bool {
+			position991, tokenIndex991 := position, tokenIndex
+			{
+				position992 := position
+				if buffer[position] != rune('m') {
+					goto l991
+				}
+				position++
+				if buffer[position] != rune('a') {
+					goto l991
+				}
+				position++
+				if buffer[position] != rune('r') {
+					goto l991
+				}
+				position++
+				if buffer[position] != rune('k') {
+					goto l991
+				}
+				position++
+				if buffer[position] != rune('-') {
+					goto l991
+				}
+				position++
+				if buffer[position] != rune('w') {
+					goto l991
+				}
+				position++
+				add(ruleMarkWidth_Key, position992)
+			}
+			return true
+		l991:
+			position, tokenIndex = position991, tokenIndex991
+			return false
+		},
+		/* 124 MarkAlign_Key <- <('m' 'a' 'r' 'k' '-' 'a' 'l' 'i' 'g' 'n')> */
+		func() bool {
+			position993, tokenIndex993 := position, tokenIndex
+			{
+				position994 := position
+				if buffer[position] != rune('m') {
+					goto l993
+				}
+				position++
+				if buffer[position] != rune('a') {
+					goto l993
+				}
+				position++
+				if buffer[position] != rune('r') {
+					goto l993
+				}
+				position++
+				if buffer[position] != rune('k') {
+					goto l993
+				}
+				position++
+				if buffer[position] != rune('-') {
+					goto l993
+				}
+				position++
+				if buffer[position] != rune('a') {
+					goto l993
+				}
+				position++
+				if buffer[position] != rune('l') {
+					goto l993
+				}
+				position++
+				if buffer[position] != rune('i') {
+					goto l993
+				}
+				position++
+				if buffer[position] != rune('g') {
+					goto l993
+				}
+				position++
+				if buffer[position] != rune('n') {
+					goto l993
+				}
+				position++
+				add(ruleMarkAlign_Key, position994)
+			}
+			return true
+		l993:
+			position, tokenIndex = position993, tokenIndex993
+			return false
+		},
+		/* 125 MarkPad_Key <- <('m' 'a' 'r' 'k' '-' 'p' 'a' 'd')> */
+		func() bool {
+			position995, tokenIndex995 := position, tokenIndex
+			{
+				position996 := position
+				if buffer[position] != rune('m') {
+					goto l995
+				}
+				position++
+				if buffer[position] != rune('a') {
+					goto l995
+				}
+				position++
+				if buffer[position] != rune('r') {
+					goto l995
+				}
+				position++
+				if buffer[position] != rune('k') {
+					goto l995
+				}
+				position++
+				if buffer[position] != rune('-') {
+					goto l995
+				}
+				position++
+				if buffer[position] != rune('p') {
+					goto l995
+				}
+				position++
+				if buffer[position] != rune('a') {
+					goto l995
+				}
+				position++
+				if buffer[position] != rune('d') {
+					goto l995
+				}
+				position++
+				add(ruleMarkPad_Key, position996)
+			}
+			return true
+		l995:
+			position, tokenIndex = position995, tokenIndex995
+			return false
+		},
+		/* 126 MarkAlpha_Key <- <('m' 'a' 'r' 'k' '-' 'a' 'l' 'p' 'h' 'a')> */
+		func() bool {
+			position997, tokenIndex997 := position, tokenIndex
+			{
+				position998 := position
+				if buffer[position] != rune('m') {
+					goto l997
+				}
+				position++
+				if buffer[position] != rune('a') {
+					goto l997
+				}
+				position++
+				if buffer[position] != rune('r') {
+					goto l997
+				}
+				position++
+				if buffer[position] != rune('k') {
+					goto l997
+				}
+				position++
+				if buffer[position] != rune('-') {
+					goto l997
+				}
+				position++
+				if buffer[position] != rune('a') {
+					goto l997
+				}
+				position++
+				if buffer[position] != rune('l') {
+					goto l997
+				}
+				position++
+				if buffer[position] != rune('p') {
+					goto l997
+				}
+				position++
+				if buffer[position] != rune('h') {
+					goto l997
+				}
+				position++
+				if buffer[position] != rune('a') {
+					goto l997
+				}
+				position++
+				add(ruleMarkAlpha_Key, position998)
+			}
+			return true
+		l997:
+			position, tokenIndex = position997, tokenIndex997
+			return false
+		},
+		/* 127 MarkScale_Key <- <('m' 'a' 'r' 'k' '-' 's' 'c' 'a' 'l' 'e')> */
+		func() bool {
+			position999, tokenIndex999 := position, tokenIndex
+			{
+				position1000 := position
+				if buffer[position] != rune('m') {
+					goto l999
+				}
+				position++
+				if buffer[position] != rune('a') {
+					goto l999
+				}
+				position++
+				if buffer[position] != rune('r') {
+					goto l999
+				}
+				position++
+				if buffer[position] != rune('k') {
+					goto l999
+				}
+				position++
+				if buffer[position] != rune('-') {
+					goto l999
+				}
+				position++
+				if buffer[position] != rune('s') {
+					goto l999
+				}
+				position++
+				if buffer[position] != rune('c') {
+					goto l999
+				}
+				position++
+				if buffer[position] != rune('a') {
+					goto l999
+				}
+				position++
+				if buffer[position] != rune('l') {
+					goto l999
+				}
+				position++
+				if buffer[position] != rune('e') {
+					goto l999
+				}
+				position++
+				add(ruleMarkScale_Key, position1000)
+			}
+			return true
+		l999:
+			position, tokenIndex = position999, tokenIndex999
+			return false
+		},
+		/* 128 Text_Key <- <('t' 'x' 't')> */
+		func() bool {
+			position1001, tokenIndex1001 := position, tokenIndex
+			{
+				position1002 := position
+				if buffer[position] != rune('t') {
+					goto l1001
+				}
+				position++
+				if buffer[position] != rune('x') {
+					goto l1001
+				}
+				position++
+				if buffer[position] != rune('t') {
+					goto l1001
+				}
+				position++
+				add(ruleText_Key, position1002)
+			}
+			return true
+		l1001:
+			position, tokenIndex = position1001, tokenIndex1001
+			return false
+		},
+		/* 129 TextSize_Key <- <('t' 'x' 't' '-' 's' 'i' 'z' 'e')> */
+		func() bool {
+			position1003, tokenIndex1003 := position, tokenIndex
+			{
+				position1004 := position
+				if buffer[position] != rune('t') {
+					goto l1003
+				}
+				position++
+				if buffer[position] != rune('x') {
+					goto l1003
+				}
+				position++
+				if buffer[position] != rune('t') {
+					goto l1003
+				}
+				position++
+				if buffer[position] != rune('-') {
+					goto l1003
+				}
+				position++
+				if buffer[position] != rune('s') {
+					goto l1003
+				}
+				position++
+				if buffer[position] != rune('i') {
+					goto l1003
+				}
+				position++
+				if buffer[position] != rune('z') {
+					goto l1003
+				}
+				position++
+				if buffer[position] != rune('e') {
+					goto l1003
+				}
+				position++
+				add(ruleTextSize_Key, position1004)
+			}
+			return true
+		l1003:
+			position, tokenIndex = position1003, tokenIndex1003
+			return false
+		},
+		/* 130 TextColor_Key <- <('t' 'x' 't' '-' 'c' 'o' 'l' 'o' 'r')> */
+		func() bool {
+			position1005, tokenIndex1005 := position, tokenIndex
+			{
+				position1006 := position
+				if buffer[position] != rune('t') {
+					goto l1005
+				}
+				position++
+				if buffer[position] != rune('x') {
+					goto l1005
+				}
+				position++
+				if buffer[position] != rune('t') {
+					goto l1005
+				}
+				position++
+				if buffer[position] != rune('-') {
+					goto l1005
+				}
+				position++
+				if buffer[position] != rune('c') {
+					goto l1005
+				}
+				position++
+				if buffer[position] != rune('o') {
+					goto l1005
+				}
+				position++
+				if buffer[position] != rune('l') {
+					goto l1005
+				}
+				position++
+				if buffer[position] != rune('o') {
+					goto l1005
+				}
+				position++
+				if buffer[position] != rune('r') {
+					goto l1005
+				}
+				position++
+				add(ruleTextColor_Key, position1006)
+			}
+			return true
+		l1005:
+			position, tokenIndex = position1005, tokenIndex1005
+			return false
+		},
+		/* 131 TextAlign_Key <- <('t' 'x' 't' '-' 'a' 'l' 'i' 'g' 'n')> */
+		func() bool {
+			position1007, tokenIndex1007 := position, tokenIndex
+			{
+				position1008 := position
+				if buffer[position] != rune('t') {
+					goto l1007
+				}
+				position++
+				if buffer[position] != rune('x') {
+					goto l1007
+				}
+				position++
+				if buffer[position] != rune('t') {
+					goto l1007
+				}
+				position++
+				if buffer[position] != rune('-') {
+					goto l1007
+				}
+				position++
+				if buffer[position] != rune('a') {
+					goto l1007
+				}
+				position++
+				if buffer[position] != rune('l') {
+					goto l1007
+				}
+				position++
+				if buffer[position] != rune('i') {
+					goto l1007
+				}
+				position++
+				if buffer[position] != rune('g') {
+					goto l1007
+				}
+				position++
+				if buffer[position] != rune('n') {
+					goto l1007
+				}
+				position++
+				add(ruleTextAlign_Key, position1008)
+			}
+			return true
+		l1007:
+			position, tokenIndex = position1007, tokenIndex1007
+			return false
+		},
+		/* 132 TextPad_Key <- <('t' 'x' 't' '-' 'p' 'a' 'd')> */
+		func() bool {
+			position1009, tokenIndex1009 := position, tokenIndex
+			{
+				position1010 := position
+				if buffer[position] != rune('t') {
+					goto l1009
+				}
+				position++
+				if buffer[position] != rune('x') {
+					goto l1009
+				}
+				position++
+				if buffer[position] != rune('t') {
+					goto l1009
+				}
+				position++
+				if buffer[position] != rune('-') {
+					goto l1009
+				}
+				position++
+				if buffer[position] != rune('p') {
+					goto l1009
+				}
+				position++
+				if buffer[position] != rune('a') {
+					goto l1009
+				}
+				position++
+				if buffer[position] != rune('d') {
+					goto l1009
+				}
+				position++
+				add(ruleTextPad_Key, position1010)
+			}
+			return true
+		l1009:
+			position, tokenIndex = position1009, tokenIndex1009
+			return false
+		},
+		/* 133 TextFont_Key <- <('t' 'x' 't' '-' 'f' 'o' 'n' 't')> */
+		func() bool {
+			position1011, tokenIndex1011 := position, tokenIndex
+			{
+				position1012 := position
+				if buffer[position] != rune('t') {
+					goto l1011
+				}
+				position++
+				if buffer[position] != rune('x') {
+					goto l1011
+				}
+				position++
+				if buffer[position] != rune('t') {
+					goto l1011
+				}
+				position++
+				if buffer[position] != rune('-') {
+					goto l1011
+				}
+				position++
+				if buffer[position] != rune('f') {
+					goto l1011
+				}
+				position++
+				if buffer[position] != rune('o') {
+					goto l1011
+				}
+				position++
+				if buffer[position] != rune('n') {
+					goto l1011
+				}
+				position++
+				if buffer[position] != rune('t') {
+					goto l1011
+				}
+				position++
+				add(ruleTextFont_Key, position1012)
+			}
+			return true
+		l1011:
+			position, tokenIndex = position1011, tokenIndex1011
+			return false
+		},
+		/* 134 CornerRadius_Key <- <('c' 'o' 'r' 'n' 'e' 'r' '-' 'r' 'a' 'd' 'i' 'u' 's')> */
+		func() bool {
+			position1013, tokenIndex1013 := position, tokenIndex
+			{
+				position1014 := position
+				if buffer[position] != rune('c') {
+					goto l1013
+				}
+				position++
+				if buffer[position] != rune('o') {
+					goto l1013
+				}
+				position++
+				if buffer[position] != rune('r') {
+					goto l1013
+				}
+				position++
+				if buffer[position] != rune('n') {
+					goto l1013
+				}
+				position++
+				if buffer[position] != rune('e') {
+					goto l1013
+				}
+				position++
+				if buffer[position] != rune('r') {
+					goto l1013
+				}
+				position++
+				if buffer[position] != rune('-') {
+					goto l1013
+				}
+				position++
+				if buffer[position] != rune('r') {
+					goto l1013
+				}
+				position++
+				if buffer[position] != rune('a') {
+					goto l1013
+				}
+				position++
+				if buffer[position] != rune('d') {
+					goto l1013
+				}
+				position++
+				if buffer[position] != rune('i') {
+					goto l1013
+				}
+				position++
+				if buffer[position] != rune('u') {
+					goto l1013
+				}
+				position++
+				if buffer[position] != rune('s') {
+					goto l1013
+				}
+				position++
+				add(ruleCornerRadius_Key, position1014)
+			}
+			return true
+		l1013:
+			position, tokenIndex = position1013, tokenIndex1013
+			return false
+		},
+		/* 135 Mask_Key <- <('m' 'a' 's' 'k')> */
+		func() bool {
+			position1015, tokenIndex1015 := position, tokenIndex
+			{
+				position1016 := position
+				if buffer[position] != rune('m') {
+					goto l1015
+				}
+				position++
+				if buffer[position] != rune('a') {
+					goto l1015
+				}
+				position++
+				if buffer[position] != rune('s') {
+					goto l1015
+				}
+				position++
+				if buffer[position] != rune('k') {
+					goto l1015
+				}
+				position++
+				add(ruleMask_Key, position1016)
+			}
+			return true
+		l1015:
+			position, tokenIndex = position1015, tokenIndex1015
+			return false
+		},
+		/* 136 Trim_Key <- <('t' 'r' 'i' 'm')> */
+		func() bool {
+			position1017, tokenIndex1017 := position, tokenIndex
+			{
+				position1018 := position
+				if buffer[position] != rune('t') {
+					goto l1017
+				}
+				position++
+				if buffer[position] != rune('r') {
+					goto l1017
+				}
+				position++
+				if buffer[position] != rune('i') {
+					goto l1017
+				}
+				position++
+				if buffer[position] != rune('m') {
+					goto l1017
+				}
+				position++
+				add(ruleTrim_Key, position1018)
+			}
+			return true
+		l1017:
+			position, tokenIndex = position1017, tokenIndex1017
+			return false
+		},
+		/* 137 TrimTol_Key <- <('t' 'r' 'i' 'm' '-' 't' 'o' 'l')> */
+		func() bool {
+			position1019, tokenIndex1019 := position, tokenIndex
+			{
+				position1020 := position
+				if buffer[position] != rune('t') {
+					goto l1019
+				}
+				position++
+				if buffer[position] != rune('r') {
+					goto l1019
+				}
+				position++
+				if buffer[position] != rune('i') {
+					goto l1019
+				}
+				position++
+				if buffer[position] != rune('m') {
+					goto l1019
+				}
+				position++
+				if buffer[position] != rune('-') {
+					goto l1019
+				}
+				position++
+				if buffer[position] != rune('t') {
+					goto l1019
+				}
+				position++
+				if buffer[position] != rune('o') {
+					goto l1019
+				}
+				position++
+				if buffer[position] != rune('l') {
+					goto l1019
+				}
+				position++
+				add(ruleTrimTol_Key, position1020)
+			}
+			return true
+		l1019:
+			position, tokenIndex = position1019, tokenIndex1019
+			return false
+		},
+		/* 138 TrimColor_Key <- <('t' 'r' 'i' 'm' '-' 'c' 'o' 'l' 'o' 'r')> */
+		func() bool {
+			position1021, tokenIndex1021 := position, tokenIndex
+			{
+				position1022 := position
+				if buffer[position] != rune('t') {
+					goto l1021
+				}
+				position++
+				if buffer[position] != rune('r') {
+					goto l1021
+				}
+				position++
+				if buffer[position] != rune('i') {
+					goto l1021
+				}
+				position++
+				if buffer[position] != rune('m') {
+					goto l1021
+				}
+				position++
+				if buffer[position] != rune('-') {
+					goto l1021
+				}
+				position++
+				if buffer[position] != rune('c') {
+					goto l1021
+				}
+				position++
+				if buffer[position] != rune('o') {
+					goto l1021
+				}
+				position++
+				if buffer[position] != rune('l') {
+					goto l1021
+				}
+				position++
+				if buffer[position] != rune('o') {
+					goto l1021
+				}
+				position++
+				if buffer[position] != rune('r') {
+					goto l1021
+				}
+				position++
+				add(ruleTrimColor_Key, position1022)
+			}
+			return true
+		l1021:
+			position, tokenIndex = position1021, tokenIndex1021
+			return false
+		},
+		/* 139 Filter_Key <- <('f' 'i' 'l' 't' 'e' 'r')> */
+		func() bool {
+			position1023, tokenIndex1023 := position, tokenIndex
+			{
+				position1024 := position
+				if buffer[position] != rune('f') {
+					goto l1023
+				}
+				position++
+				if buffer[position] != rune('i') {
+					goto l1023
+				}
+				position++
+				if buffer[position] != rune('l') {
+					goto l1023
+				}
+				position++
+				if buffer[position] != rune('t') {
+					goto l1023
+				}
+				position++
+				if buffer[position] != rune('e') {
+					goto l1023
+				}
+				position++
+				if buffer[position] != rune('r') {
+					goto l1023
+				}
+				position++
+				add(ruleFilter_Key, position1024)
+			}
+			return true
+		l1023:
+			position, tokenIndex = position1023, tokenIndex1023
+			return false
+		},
+		/* 140 Upscale_Key <- <('u' 'p' 's' 'c' 'a' 'l' 'e')> */
+		func() bool {
+			position1025, tokenIndex1025 := position, tokenIndex
+			{
+				position1026 := position
+				if buffer[position] != rune('u') {
+					goto l1025
+				}
+				position++
+				if buffer[position] != rune('p') {
+					goto l1025
+				}
+				position++
+				if buffer[position] != rune('s') {
+					goto l1025
+				}
+				position++
+				if buffer[position] != rune('c') {
+					goto l1025
+				}
+				position++
+				if buffer[position] != rune('a') {
+					goto l1025
+				}
+				position++
+				if buffer[position] != rune('l') {
+					goto l1025
+				}
+				position++
+				if buffer[position] != rune('e') {
+					goto l1025
+				}
+				position++
+				add(ruleUpscale_Key, position1026)
+			}
+			return true
+		l1025:
+			position, tokenIndex = position1025, tokenIndex1025
+			return false
+		},
+		/* 141 Palette_Key <- <('p' 'a' 'l' 'e' 't' 't' 'e')> */
+		func() bool {
+			position1027, tokenIndex1027 := position, tokenIndex
+			{
+				position1028 := position
+				if buffer[position] != rune('p') {
+					goto l1027
+				}
+				position++
+				if buffer[position] != rune('a') {
+					goto l1027
+				}
+				position++
+				if buffer[position] != rune('l') {
+					goto l1027
+				}
+				position++
+				if buffer[position] != rune('e') {
+					goto l1027
+				}
+				position++
+				if buffer[position] != rune('t') {
+					goto l1027
+				}
+				position++
+				if buffer[position] != rune('t') {
+					goto l1027
+				}
+				position++
+				if buffer[position] != rune('e') {
+					goto l1027
+				}
+				position++
+				add(rulePalette_Key, position1028)
+			}
+			return true
+		l1027:
+			position, tokenIndex = position1027, tokenIndex1027
+			return false
+		},
+		/* 142 Colors_Key <- <('c' 'o' 'l' 'o' 'r' 's')> */
+		func() bool {
+			position1029, tokenIndex1029 := position, tokenIndex
+			{
+				position1030 := position
+				if buffer[position] != rune('c') {
+					goto l1029
+				}
+				position++
+				if buffer[position] != rune('o') {
+					goto l1029
+				}
+				position++
+				if buffer[position] != rune('l') {
+					goto l1029
+				}
+				position++
+				if buffer[position] != rune('o') {
+					goto l1029
+				}
+				position++
+				if buffer[position] != rune('r') {
+					goto l1029
+				}
+				position++
+				if buffer[position] != rune('s') {
+					goto l1029
 				}
-				add(ruleFit, position65)
+				position++
+				add(ruleColors_Key, position1030)
 			}
 			return true
-		l64:
-			position, tokenIndex = position64, tokenIndex64
+		l1029:
+			position, tokenIndex = position1029, tokenIndex1029
 			return false
 		},
-		/* 6 Scale <- <(Scale_Key Separater <(Digit / Dot)+> (&And / EOF) Action5)> */
+		/* 143 Lqip_Key <- <('l' 'q' 'i' 'p')> */
 		func() bool {
-			position70, tokenIndex70 := position, tokenIndex
+			position1031, tokenIndex1031 := position, tokenIndex
 			{
-				position71 := position
-				if !_rules[ruleScale_Key]() {
-					goto l70
+				position1032 := position
+				if buffer[position] != rune('l') {
+					goto l1031
 				}
-				if !_rules[ruleSeparater]() {
-					goto l70
+				position++
+				if buffer[position] != rune('q') {
+					goto l1031
+				}
+				position++
+				if buffer[position] != rune('i') {
+					goto l1031
+				}
+				position++
+				if buffer[position] != rune('p') {
+					goto l1031
+				}
+				position++
+				add(ruleLqip_Key, position1032)
+			}
+			return true
+		l1031:
+			position, tokenIndex = position1031, tokenIndex1031
+			return false
+		},
+		/* 144 PHash_Key <- <('p' 'h' 'a' 's' 'h')> */
+		func() bool {
+			position1033, tokenIndex1033 := position, tokenIndex
+			{
+				position1034 := position
+				if buffer[position] != rune('p') {
+					goto l1033
+				}
+				position++
+				if buffer[position] != rune('h') {
+					goto l1033
+				}
+				position++
+				if buffer[position] != rune('a') {
+					goto l1033
+				}
+				position++
+				if buffer[position] != rune('s') {
+					goto l1033
+				}
+				position++
+				if buffer[position] != rune('h') {
+					goto l1033
+				}
+				position++
+				add(rulePHash_Key, position1034)
+			}
+			return true
+		l1033:
+			position, tokenIndex = position1033, tokenIndex1033
+			return false
+		},
+		/* 145 Frame_Key <- <('f' 'r' 'a' 'm' 'e')> */
+		func() bool {
+			position1035, tokenIndex1035 := position, tokenIndex
+			{
+				position1036 := position
+				if buffer[position] != rune('f') {
+					goto l1035
+				}
+				position++
+				if buffer[position] != rune('r') {
+					goto l1035
+				}
+				position++
+				if buffer[position] != rune('a') {
+					goto l1035
+				}
+				position++
+				if buffer[position] != rune('m') {
+					goto l1035
+				}
+				position++
+				if buffer[position] != rune('e') {
+					goto l1035
+				}
+				position++
+				add(ruleFrame_Key, position1036)
+			}
+			return true
+		l1035:
+			position, tokenIndex = position1035, tokenIndex1035
+			return false
+		},
+		/* 146 MaxBytes_Key <- <('m' 'a' 'x' '-' 'b' 'y' 't' 'e' 's')> */
+		func() bool {
+			position1037, tokenIndex1037 := position, tokenIndex
+			{
+				position1038 := position
+				if buffer[position] != rune('m') {
+					goto l1037
+				}
+				position++
+				if buffer[position] != rune('a') {
+					goto l1037
+				}
+				position++
+				if buffer[position] != rune('x') {
+					goto l1037
+				}
+				position++
+				if buffer[position] != rune('-') {
+					goto l1037
+				}
+				position++
+				if buffer[position] != rune('b') {
+					goto l1037
+				}
+				position++
+				if buffer[position] != rune('y') {
+					goto l1037
+				}
+				position++
+				if buffer[position] != rune('t') {
+					goto l1037
+				}
+				position++
+				if buffer[position] != rune('e') {
+					goto l1037
+				}
+				position++
+				if buffer[position] != rune('s') {
+					goto l1037
+				}
+				position++
+				add(ruleMaxBytes_Key, position1038)
+			}
+			return true
+		l1037:
+			position, tokenIndex = position1037, tokenIndex1037
+			return false
+		},
+		/* 147 Lossless_Key <- <('l' 'o' 's' 's' 'l' 'e' 's' 's')> */
+		func() bool {
+			position1039, tokenIndex1039 := position, tokenIndex
+			{
+				position1040 := position
+				if buffer[position] != rune('l') {
+					goto l1039
+				}
+				position++
+				if buffer[position] != rune('o') {
+					goto l1039
+				}
+				position++
+				if buffer[position] != rune('s') {
+					goto l1039
+				}
+				position++
+				if buffer[position] != rune('s') {
+					goto l1039
+				}
+				position++
+				if buffer[position] != rune('l') {
+					goto l1039
+				}
+				position++
+				if buffer[position] != rune('e') {
+					goto l1039
+				}
+				position++
+				if buffer[position] != rune('s') {
+					goto l1039
+				}
+				position++
+				if buffer[position] != rune('s') {
+					goto l1039
+				}
+				position++
+				add(ruleLossless_Key, position1040)
+			}
+			return true
+		l1039:
+			position, tokenIndex = position1039, tokenIndex1039
+			return false
+		},
+		/* 148 Dither_Key <- <('d' 'i' 't' 'h' 'e' 'r')> */
+		func() bool {
+			position1041, tokenIndex1041 := position, tokenIndex
+			{
+				position1042 := position
+				if buffer[position] != rune('d') {
+					goto l1041
+				}
+				position++
+				if buffer[position] != rune('i') {
+					goto l1041
+				}
+				position++
+				if buffer[position] != rune('t') {
+					goto l1041
 				}
+				position++
+				if buffer[position] != rune('h') {
+					goto l1041
+				}
+				position++
+				if buffer[position] != rune('e') {
+					goto l1041
+				}
+				position++
+				if buffer[position] != rune('r') {
+					goto l1041
+				}
+				position++
+				add(ruleDither_Key, position1042)
+			}
+			return true
+		l1041:
+			position, tokenIndex = position1041, tokenIndex1041
+			return false
+		},
+		/* 149 Top_Key <- <(('t' 'o' 'p') / 't')> */
+		func() bool {
+			position1043, tokenIndex1043 := position, tokenIndex
+			{
+				position1044 := position
 				{
-					position72 := position
-					{
-						position75, tokenIndex75 := position, tokenIndex
-						if !_rules[ruleDigit]() {
-							goto l76
-						}
-						goto l75
-					l76:
-						position, tokenIndex = position75, tokenIndex75
-						if !_rules[ruleDot]() {
-							goto l70
-						}
+					position1045, tokenIndex1045 := position, tokenIndex
+					if buffer[position] != rune('t') {
+						goto l1046
 					}
-				l75:
-				l73:
-					{
-						position74, tokenIndex74 := position, tokenIndex
-						{
-							position77, tokenIndex77 := position, tokenIndex
-							if !_rules[ruleDigit]() {
-								goto l78
-							}
-							goto l77
-						l78:
-							position, tokenIndex = position77, tokenIndex77
-							if !_rules[ruleDot]() {
-								goto l74
-							}
-						}
-					l77:
-						goto l73
-					l74:
-						position, tokenIndex = position74, tokenIndex74
+					position++
+					if buffer[position] != rune('o') {
+						goto l1046
+					}
+					position++
+					if buffer[position] != rune('p') {
+						goto l1046
+					}
+					position++
+					goto l1045
+				l1046:
+					position, tokenIndex = position1045, tokenIndex1045
+					if buffer[position] != rune('t') {
+						goto l1043
+					}
+					position++
+				}
+			l1045:
+				add(ruleTop_Key, position1044)
+			}
+			return true
+		l1043:
+			position, tokenIndex = position1043, tokenIndex1043
+			return false
+		},
+		/* 150 Right_Key <- <(('r' 'i' 'g' 'h' 't') / 'r')> */
+		func() bool {
+			position1047, tokenIndex1047 := position, tokenIndex
+			{
+				position1048 := position
+				{
+					position1049, tokenIndex1049 := position, tokenIndex
+					if buffer[position] != rune('r') {
+						goto l1050
+					}
+					position++
+					if buffer[position] != rune('i') {
+						goto l1050
+					}
+					position++
+					if buffer[position] != rune('g') {
+						goto l1050
+					}
+					position++
+					if buffer[position] != rune('h') {
+						goto l1050
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l1050
+					}
+					position++
+					goto l1049
+				l1050:
+					position, tokenIndex = position1049, tokenIndex1049
+					if buffer[position] != rune('r') {
+						goto l1047
 					}
-					add(rulePegText, position72)
+					position++
 				}
+			l1049:
+				add(ruleRight_Key, position1048)
+			}
+			return true
+		l1047:
+			position, tokenIndex = position1047, tokenIndex1047
+			return false
+		},
+		/* 151 Bottom_Key <- <(('b' 'o' 't' 't' 'o' 'm') / 'b')> */
+		func() bool {
+			position1051, tokenIndex1051 := position, tokenIndex
+			{
+				position1052 := position
 				{
-					position79, tokenIndex79 := position, tokenIndex
-					{
-						position81, tokenIndex81 := position, tokenIndex
-						if !_rules[ruleAnd]() {
-							goto l80
-						}
-						position, tokenIndex = position81, tokenIndex81
+					position1053, tokenIndex1053 := position, tokenIndex
+					if buffer[position] != rune('b') {
+						goto l1054
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l1054
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l1054
+					}
+					position++
+					if buffer[position] != rune('t') {
+						goto l1054
+					}
+					position++
+					if buffer[position] != rune('o') {
+						goto l1054
+					}
+					position++
+					if buffer[position] != rune('m') {
+						goto l1054
+					}
+					position++
+					goto l1053
+				l1054:
+					position, tokenIndex = position1053, tokenIndex1053
+					if buffer[position] != rune('b') {
+						goto l1051
+					}
+					position++
+				}
+			l1053:
+				add(ruleBottom_Key, position1052)
+			}
+			return true
+		l1051:
+			position, tokenIndex = position1051, tokenIndex1051
+			return false
+		},
+		/* 152 Left_Key <- <(('l' 'e' 'f' 't') / 'l')> */
+		func() bool {
+			position1055, tokenIndex1055 := position, tokenIndex
+			{
+				position1056 := position
+				{
+					position1057, tokenIndex1057 := position, tokenIndex
+					if buffer[position] != rune('l') {
+						goto l1058
+					}
+					position++
+					if buffer[position] != rune('e') {
+						goto l1058
+					}
+					position++
+					if buffer[position] != rune('f') {
+						goto l1058
 					}
-					goto l79
-				l80:
-					position, tokenIndex = position79, tokenIndex79
-					if !_rules[ruleEOF]() {
-						goto l70
+					position++
+					if buffer[position] != rune('t') {
+						goto l1058
 					}
+					position++
+					goto l1057
+				l1058:
+					position, tokenIndex = position1057, tokenIndex1057
+					if buffer[position] != rune('l') {
+						goto l1055
+					}
+					position++
 				}
-			l79:
-				if !_rules[ruleAction5]() {
-					goto l70
-				}
-				add(ruleScale, position71)
+			l1057:
+				add(ruleLeft_Key, position1056)
 			}
 			return true
-		l70:
-			position, tokenIndex = position70, tokenIndex70
+		l1055:
+			position, tokenIndex = position1055, tokenIndex1055
 			return false
 		},
-		/* 7 Reverse <- <(Reverse_Key Separater <ReverseParam> (&And / EOF) Action6)> */
+		/* 153 Equal <- <'='> */
 		func() bool {
-			position82, tokenIndex82 := position, tokenIndex
+			position1059, tokenIndex1059 := position, tokenIndex
 			{
-				position83 := position
-				if !_rules[ruleReverse_Key]() {
-					goto l82
-				}
-				if !_rules[ruleSeparater]() {
-					goto l82
-				}
-				{
-					position84 := position
-					if !_rules[ruleReverseParam]() {
-						goto l82
-					}
-					add(rulePegText, position84)
-				}
-				{
-					position85, tokenIndex85 := position, tokenIndex
-					{
-						position87, tokenIndex87 := position, tokenIndex
-						if !_rules[ruleAnd]() {
-							goto l86
-						}
-						position, tokenIndex = position87, tokenIndex87
-					}
-					goto l85
-				l86:
-					position, tokenIndex = position85, tokenIndex85
-					if !_rules[ruleEOF]() {
-						goto l82
-					}
-				}
-			l85:
-				if !_rules[ruleAction6]() {
-					goto l82
+				position1060 := position
+				if buffer[position] != rune('=') {
+					goto l1059
 				}
-				add(ruleReverse, position83)
+				position++
+				add(ruleEqual, position1060)
 			}
 			return true
-		l82:
-			position, tokenIndex = position82, tokenIndex82
+		l1059:
+			position, tokenIndex = position1059, tokenIndex1059
 			return false
 		},
-		/* 8 Crop <- <(Crop_Key CropSub_P (&And / EOF))> */
+		/* 154 Question <- <'?'> */
 		func() bool {
-			position88, tokenIndex88 := position, tokenIndex
+			position1061, tokenIndex1061 := position, tokenIndex
 			{
-				position89 := position
-				if !_rules[ruleCrop_Key]() {
-					goto l88
-				}
-				if !_rules[ruleCropSub_P]() {
-					goto l88
-				}
-				{
-					position90, tokenIndex90 := position, tokenIndex
-					{
-						position92, tokenIndex92 := position, tokenIndex
-						if !_rules[ruleAnd]() {
-							goto l91
-						}
-						position, tokenIndex = position92, tokenIndex92
-					}
-					goto l90
-				l91:
-					position, tokenIndex = position90, tokenIndex90
-					if !_rules[ruleEOF]() {
-						goto l88
-					}
+				position1062 := position
+				if buffer[position] != rune('?') {
+					goto l1061
 				}
-			l90:
-				add(ruleCrop, position89)
+				position++
+				add(ruleQuestion, position1062)
 			}
 			return true
-		l88:
-			position, tokenIndex = position88, tokenIndex88
+		l1061:
+			position, tokenIndex = position1061, tokenIndex1061
 			return false
 		},
-		/* 9 CropSub_P <- <(Open CropSub_Set+ Close)> */
+		/* 155 And <- <'&'> */
 		func() bool {
-			position93, tokenIndex93 := position, tokenIndex
+			position1063, tokenIndex1063 := position, tokenIndex
 			{
-				position94 := position
-				if !_rules[ruleOpen]() {
-					goto l93
-				}
-				if !_rules[ruleCropSub_Set]() {
-					goto l93
-				}
-			l95:
-				{
-					position96, tokenIndex96 := position, tokenIndex
-					if !_rules[ruleCropSub_Set]() {
-						goto l96
-					}
-					goto l95
-				l96:
-					position, tokenIndex = position96, tokenIndex96
-				}
-				if !_rules[ruleClose]() {
-					goto l93
+				position1064 := position
+				if buffer[position] != rune('&') {
+					goto l1063
 				}
-				add(ruleCropSub_P, position94)
+				position++
+				add(ruleAnd, position1064)
 			}
 			return true
-		l93:
-			position, tokenIndex = position93, tokenIndex93
+		l1063:
+			position, tokenIndex = position1063, tokenIndex1063
 			return false
 		},
-		/* 10 CropSub_Set <- <(Separater? (CropSub_Key_Width / CropSub_Key_Height / CropSub_Key_X / CropSub_Key_Y))> */
+		/* 156 Dot <- <'.'> */
 		func() bool {
-			position97, tokenIndex97 := position, tokenIndex
+			position1065, tokenIndex1065 := position, tokenIndex
 			{
-				position98 := position
-				{
-					position99, tokenIndex99 := position, tokenIndex
-					if !_rules[ruleSeparater]() {
-						goto l99
-					}
-					goto l100
-				l99:
-					position, tokenIndex = position99, tokenIndex99
-				}
-			l100:
-				{
-					position101, tokenIndex101 := position, tokenIndex
-					if !_rules[ruleCropSub_Key_Width]() {
-						goto l102
-					}
-					goto l101
-				l102:
-					position, tokenIndex = position101, tokenIndex101
-					if !_rules[ruleCropSub_Key_Height]() {
-						goto l103
-					}
-					goto l101
-				l103:
-					position, tokenIndex = position101, tokenIndex101
-					if !_rules[ruleCropSub_Key_X]() {
-						goto l104
-					}
-					goto l101
-				l104:
-					position, tokenIndex = position101, tokenIndex101
-					if !_rules[ruleCropSub_Key_Y]() {
-						goto l97
-					}
+				position1066 := position
+				if buffer[position] != rune('.') {
+					goto l1065
 				}
-			l101:
-				add(ruleCropSub_Set, position98)
+				position++
+				add(ruleDot, position1066)
 			}
 			return true
-		l97:
-			position, tokenIndex = position97, tokenIndex97
+		l1065:
+			position, tokenIndex = position1065, tokenIndex1065
 			return false
 		},
-		/* 11 CropSub_Key_Width <- <(Width_Key Separater? <(Digit / Dot)+> Action7)> */
+		/* 157 Comma <- <','> */
 		func() bool {
-			position105, tokenIndex105 := position, tokenIndex
+			position1067, tokenIndex1067 := position, tokenIndex
 			{
-				position106 := position
-				if !_rules[ruleWidth_Key]() {
-					goto l105
-				}
-				{
-					position107, tokenIndex107 := position, tokenIndex
-					if !_rules[ruleSeparater]() {
-						goto l107
-					}
-					goto l108
-				l107:
-					position, tokenIndex = position107, tokenIndex107
+				position1068 := position
+				if buffer[position] != rune(',') {
+					goto l1067
 				}
-			l108:
-				{
-					position109 := position
-					{
-						position112, tokenIndex112 := position, tokenIndex
-						if !_rules[ruleDigit]() {
-							goto l113
-						}
-						goto l112
-					l113:
-						position, tokenIndex = position112, tokenIndex112
-						if !_rules[ruleDot]() {
-							goto l105
-						}
-					}
-				l112:
-				l110:
-					{
-						position111, tokenIndex111 := position, tokenIndex
-						{
-							position114, tokenIndex114 := position, tokenIndex
-							if !_rules[ruleDigit]() {
-								goto l115
-							}
-							goto l114
-						l115:
-							position, tokenIndex = position114, tokenIndex114
-							if !_rules[ruleDot]() {
-								goto l111
-							}
-						}
-					l114:
-						goto l110
-					l111:
-						position, tokenIndex = position111, tokenIndex111
-					}
-					add(rulePegText, position109)
+				position++
+				add(ruleComma, position1068)
+			}
+			return true
+		l1067:
+			position, tokenIndex = position1067, tokenIndex1067
+			return false
+		},
+		/* 158 Colon <- <':'> */
+		func() bool {
+			position1069, tokenIndex1069 := position, tokenIndex
+			{
+				position1070 := position
+				if buffer[position] != rune(':') {
+					goto l1069
 				}
-				if !_rules[ruleAction7]() {
-					goto l105
+				position++
+				add(ruleColon, position1070)
+			}
+			return true
+		l1069:
+			position, tokenIndex = position1069, tokenIndex1069
+			return false
+		},
+		/* 159 Haihun <- <'-'> */
+		func() bool {
+			position1071, tokenIndex1071 := position, tokenIndex
+			{
+				position1072 := position
+				if buffer[position] != rune('-') {
+					goto l1071
 				}
-				add(ruleCropSub_Key_Width, position106)
+				position++
+				add(ruleHaihun, position1072)
 			}
 			return true
-		l105:
-			position, tokenIndex = position105, tokenIndex105
+		l1071:
+			position, tokenIndex = position1071, tokenIndex1071
 			return false
 		},
-		/* 12 CropSub_Key_Height <- <(Height_Key Separater? <(Digit / Dot)+> Action8)> */
+		/* 160 Open_P <- <'('> */
 		func() bool {
-			position116, tokenIndex116 := position, tokenIndex
+			position1073, tokenIndex1073 := position, tokenIndex
 			{
-				position117 := position
-				if !_rules[ruleHeight_Key]() {
-					goto l116
+				position1074 := position
+				if buffer[position] != rune('(') {
+					goto l1073
 				}
-				{
-					position118, tokenIndex118 := position, tokenIndex
-					if !_rules[ruleSeparater]() {
-						goto l118
-					}
-					goto l119
-				l118:
-					position, tokenIndex = position118, tokenIndex118
+				position++
+				add(ruleOpen_P, position1074)
+			}
+			return true
+		l1073:
+			position, tokenIndex = position1073, tokenIndex1073
+			return false
+		},
+		/* 161 Close_P <- <')'> */
+		func() bool {
+			position1075, tokenIndex1075 := position, tokenIndex
+			{
+				position1076 := position
+				if buffer[position] != rune(')') {
+					goto l1075
 				}
-			l119:
-				{
-					position120 := position
-					{
-						position123, tokenIndex123 := position, tokenIndex
-						if !_rules[ruleDigit]() {
-							goto l124
-						}
-						goto l123
-					l124:
-						position, tokenIndex = position123, tokenIndex123
-						if !_rules[ruleDot]() {
-							goto l116
-						}
-					}
-				l123:
-				l121:
-					{
-						position122, tokenIndex122 := position, tokenIndex
-						{
-							position125, tokenIndex125 := position, tokenIndex
-							if !_rules[ruleDigit]() {
-								goto l126
-							}
-							goto l125
-						l126:
-							position, tokenIndex = position125, tokenIndex125
-							if !_rules[ruleDot]() {
-								goto l122
-							}
-						}
-					l125:
-						goto l121
-					l122:
-						position, tokenIndex = position122, tokenIndex122
-					}
-					add(rulePegText, position120)
+				position++
+				add(ruleClose_P, position1076)
+			}
+			return true
+		l1075:
+			position, tokenIndex = position1075, tokenIndex1075
+			return false
+		},
+		/* 162 Open_B <- <'{'> */
+		func() bool {
+			position1077, tokenIndex1077 := position, tokenIndex
+			{
+				position1078 := position
+				if buffer[position] != rune('{') {
+					goto l1077
 				}
-				if !_rules[ruleAction8]() {
-					goto l116
+				position++
+				add(ruleOpen_B, position1078)
+			}
+			return true
+		l1077:
+			position, tokenIndex = position1077, tokenIndex1077
+			return false
+		},
+		/* 163 Close_B <- <'}'> */
+		func() bool {
+			position1079, tokenIndex1079 := position, tokenIndex
+			{
+				position1080 := position
+				if buffer[position] != rune('}') {
+					goto l1079
 				}
-				add(ruleCropSub_Key_Height, position117)
+				position++
+				add(ruleClose_B, position1080)
 			}
 			return true
-		l116:
-			position, tokenIndex = position116, tokenIndex116
+		l1079:
+			position, tokenIndex = position1079, tokenIndex1079
 			return false
 		},
-		/* 13 CropSub_Key_X <- <('x' Separater? <(Digit / Dot)+> Action9)> */
+		/* 164 Open_Box <- <'['> */
 		func() bool {
-			position127, tokenIndex127 := position, tokenIndex
+			position1081, tokenIndex1081 := position, tokenIndex
 			{
-				position128 := position
-				if buffer[position] != rune('x') {
-					goto l127
+				position1082 := position
+				if buffer[position] != rune('[') {
+					goto l1081
 				}
 				position++
-				{
-					position129, tokenIndex129 := position, tokenIndex
-					if !_rules[ruleSeparater]() {
-						goto l129
-					}
-					goto l130
-				l129:
-					position, tokenIndex = position129, tokenIndex129
+				add(ruleOpen_Box, position1082)
+			}
+			return true
+		l1081:
+			position, tokenIndex = position1081, tokenIndex1081
+			return false
+		},
+		/* 165 Close_Box <- <']'> */
+		func() bool {
+			position1083, tokenIndex1083 := position, tokenIndex
+			{
+				position1084 := position
+				if buffer[position] != rune(']') {
+					goto l1083
 				}
-			l130:
+				position++
+				add(ruleClose_Box, position1084)
+			}
+			return true
+		l1083:
+			position, tokenIndex = position1083, tokenIndex1083
+			return false
+		},
+		/* 166 EOF <- <!.> */
+		func() bool {
+			position1085, tokenIndex1085 := position, tokenIndex
+			{
+				position1086 := position
 				{
-					position131 := position
-					{
-						position134, tokenIndex134 := position, tokenIndex
-						if !_rules[ruleDigit]() {
-							goto l135
-						}
-						goto l134
-					l135:
-						position, tokenIndex = position134, tokenIndex134
-						if !_rules[ruleDot]() {
-							goto l127
-						}
-					}
-				l134:
-				l132:
-					{
-						position133, tokenIndex133 := position, tokenIndex
-						{
-							position136, tokenIndex136 := position, tokenIndex
-							if !_rules[ruleDigit]() {
-								goto l137
-							}
-							goto l136
-						l137:
-							position, tokenIndex = position136, tokenIndex136
-							if !_rules[ruleDot]() {
-								goto l133
-							}
-						}
-					l136:
-						goto l132
-					l133:
-						position, tokenIndex = position133, tokenIndex133
+					position1087, tokenIndex1087 := position, tokenIndex
+					if !matchDot() {
+						goto l1087
 					}
-					add(rulePegText, position131)
-				}
-				if !_rules[ruleAction9]() {
-					goto l127
+					goto l1085
+				l1087:
+					position, tokenIndex = position1087, tokenIndex1087
 				}
-				add(ruleCropSub_Key_X, position128)
+				add(ruleEOF, position1086)
+			}
+			return true
+		l1085:
+			position, tokenIndex = position1085, tokenIndex1085
+			return false
+		},
+		nil,
+		/* 169 Action0 <- <{ p.AddParam("format", text) }> */
+		func() bool {
+			{
+				add(ruleAction0, position)
+			}
+			return true
+		},
+		/* 170 Action1 <- <{ p.AddParam("progressive", text) }> */
+		func() bool {
+			{
+				add(ruleAction1, position)
+			}
+			return true
+		},
+		/* 171 Action2 <- <{ p.AddParam("width", text) }> */
+		func() bool {
+			{
+				add(ruleAction2, position)
+			}
+			return true
+		},
+		/* 172 Action3 <- <{ p.AddParam("height", text) }> */
+		func() bool {
+			{
+				add(ruleAction3, position)
+			}
+			return true
+		},
+		/* 173 Action4 <- <{ p.AddParam("fit", text) }> */
+		func() bool {
+			{
+				add(ruleAction4, position)
+			}
+			return true
+		},
+		/* 174 Action5 <- <{ p.AddParam("scale", text) }> */
+		func() bool {
+			{
+				add(ruleAction5, position)
+			}
+			return true
+		},
+		/* 175 Action6 <- <{ p.AddParam("reverse", text) }> */
+		func() bool {
+			{
+				add(ruleAction6, position)
+			}
+			return true
+		},
+		/* 176 Action7 <- <{ p.AddTupleParam("crop") }> */
+		func() bool {
+			{
+				add(ruleAction7, position)
+			}
+			return true
+		},
+		/* 177 Action8 <- <{ p.AddParam("quality", text) }> */
+		func() bool {
+			{
+				add(ruleAction8, position)
+			}
+			return true
+		},
+		/* 178 Action9 <- <{ p.AddParam("exif", text) }> */
+		func() bool {
+			{
+				add(ruleAction9, position)
 			}
 			return true
-		l127:
-			position, tokenIndex = position127, tokenIndex127
-			return false
 		},
-		/* 14 CropSub_Key_Y <- <('y' Separater? <(Digit / Dot)+> Action10)> */
+		/* 179 Action10 <- <{ p.AddParam("strip-gps", text) }> */
 		func() bool {
-			position138, tokenIndex138 := position, tokenIndex
 			{
-				position139 := position
-				if buffer[position] != rune('y') {
-					goto l138
-				}
-				position++
-				{
-					position140, tokenIndex140 := position, tokenIndex
-					if !_rules[ruleSeparater]() {
-						goto l140
-					}
-					goto l141
-				l140:
-					position, tokenIndex = position140, tokenIndex140
-				}
-			l141:
-				{
-					position142 := position
-					{
-						position145, tokenIndex145 := position, tokenIndex
-						if !_rules[ruleDigit]() {
-							goto l146
-						}
-						goto l145
-					l146:
-						position, tokenIndex = position145, tokenIndex145
-						if !_rules[ruleDot]() {
-							goto l138
-						}
-					}
-				l145:
-				l143:
-					{
-						position144, tokenIndex144 := position, tokenIndex
-						{
-							position147, tokenIndex147 := position, tokenIndex
-							if !_rules[ruleDigit]() {
-								goto l148
-							}
-							goto l147
-						l148:
-							position, tokenIndex = position147, tokenIndex147
-							if !_rules[ruleDot]() {
-								goto l144
-							}
-						}
-					l147:
-						goto l143
-					l144:
-						position, tokenIndex = position144, tokenIndex144
-					}
-					add(rulePegText, position142)
-				}
-				if !_rules[ruleAction10]() {
-					goto l138
-				}
-				add(ruleCropSub_Key_Y, position139)
+				add(ruleAction10, position)
 			}
 			return true
-		l138:
-			position, tokenIndex = position138, tokenIndex138
-			return false
 		},
-		/* 15 Quality <- <(Quality_Key Separater <(Digit / Dot)+> (&And / EOF) Action11)> */
+		/* 180 Action11 <- <{ p.AddParam("ar", text) }> */
 		func() bool {
-			position149, tokenIndex149 := position, tokenIndex
 			{
-				position150 := position
-				if !_rules[ruleQuality_Key]() {
-					goto l149
-				}
-				if !_rules[ruleSeparater]() {
-					goto l149
-				}
-				{
-					position151 := position
-					{
-						position154, tokenIndex154 := position, tokenIndex
-						if !_rules[ruleDigit]() {
-							goto l155
-						}
-						goto l154
-					l155:
-						position, tokenIndex = position154, tokenIndex154
-						if !_rules[ruleDot]() {
-							goto l149
-						}
-					}
-				l154:
-				l152:
-					{
-						position153, tokenIndex153 := position, tokenIndex
-						{
-							position156, tokenIndex156 := position, tokenIndex
-							if !_rules[ruleDigit]() {
-								goto l157
-							}
-							goto l156
-						l157:
-							position, tokenIndex = position156, tokenIndex156
-							if !_rules[ruleDot]() {
-								goto l153
-							}
-						}
-					l156:
-						goto l152
-					l153:
-						position, tokenIndex = position153, tokenIndex153
-					}
-					add(rulePegText, position151)
-				}
-				{
-					position158, tokenIndex158 := position, tokenIndex
-					{
-						position160, tokenIndex160 := position, tokenIndex
-						if !_rules[ruleAnd]() {
-							goto l159
-						}
-						position, tokenIndex = position160, tokenIndex160
-					}
-					goto l158
-				l159:
-					position, tokenIndex = position158, tokenIndex158
-					if !_rules[ruleEOF]() {
-						goto l149
-					}
-				}
-			l158:
-				if !_rules[ruleAction11]() {
-					goto l149
-				}
-				add(ruleQuality, position150)
+				add(ruleAction11, position)
 			}
 			return true
-		l149:
-			position, tokenIndex = position149, tokenIndex149
-			return false
 		},
-		/* 16 Exif <- <(Exif_Key Separater <Bool> (&And / EOF) Action12)> */
+		/* 181 Action12 <- <{ p.AddParam("bg", text) }> */
 		func() bool {
-			position161, tokenIndex161 := position, tokenIndex
 			{
-				position162 := position
-				if !_rules[ruleExif_Key]() {
-					goto l161
-				}
-				if !_rules[ruleSeparater]() {
-					goto l161
-				}
-				{
-					position163 := position
-					if !_rules[ruleBool]() {
-						goto l161
-					}
-					add(rulePegText, position163)
-				}
-				{
-					position164, tokenIndex164 := position, tokenIndex
-					{
-						position166, tokenIndex166 := position, tokenIndex
-						if !_rules[ruleAnd]() {
-							goto l165
-						}
-						position, tokenIndex = position166, tokenIndex166
-					}
-					goto l164
-				l165:
-					position, tokenIndex = position164, tokenIndex164
-					if !_rules[ruleEOF]() {
-						goto l161
-					}
-				}
-			l164:
-				if !_rules[ruleAction12]() {
-					goto l161
-				}
-				add(ruleExif, position162)
+				add(ruleAction12, position)
 			}
 			return true
-		l161:
-			position, tokenIndex = position161, tokenIndex161
-			return false
 		},
-		/* 17 SkipParam <- <(<(All (&And / EOF))> Action13)> */
+		/* 182 Action13 <- <{ p.AddParam("dpr", text) }> */
 		func() bool {
-			position167, tokenIndex167 := position, tokenIndex
 			{
-				position168 := position
-				{
-					position169 := position
-					if !_rules[ruleAll]() {
-						goto l167
-					}
-					{
-						position170, tokenIndex170 := position, tokenIndex
-						{
-							position172, tokenIndex172 := position, tokenIndex
-							if !_rules[ruleAnd]() {
-								goto l171
-							}
-							position, tokenIndex = position172, tokenIndex172
-						}
-						goto l170
-					l171:
-						position, tokenIndex = position170, tokenIndex170
-						if !_rules[ruleEOF]() {
-							goto l167
-						}
-					}
-				l170:
-					add(rulePegText, position169)
-				}
-				if !_rules[ruleAction13]() {
-					goto l167
-				}
-				add(ruleSkipParam, position168)
+				add(ruleAction13, position)
 			}
 			return true
-		l167:
-			position, tokenIndex = position167, tokenIndex167
-			return false
 		},
-		/* 18 Separater <- <(Equal / Dot / Haihun / Comma)> */
+		/* 183 Action14 <- <{ p.AddParam("bri", text) }> */
 		func() bool {
-			position173, tokenIndex173 := position, tokenIndex
 			{
-				position174 := position
-				{
-					position175, tokenIndex175 := position, tokenIndex
-					if !_rules[ruleEqual]() {
-						goto l176
-					}
-					goto l175
-				l176:
-					position, tokenIndex = position175, tokenIndex175
-					if !_rules[ruleDot]() {
-						goto l177
-					}
-					goto l175
-				l177:
-					position, tokenIndex = position175, tokenIndex175
-					if !_rules[ruleHaihun]() {
-						goto l178
-					}
-					goto l175
-				l178:
-					position, tokenIndex = position175, tokenIndex175
-					if !_rules[ruleComma]() {
-						goto l173
-					}
-				}
-			l175:
-				add(ruleSeparater, position174)
+				add(ruleAction14, position)
 			}
 			return true
-		l173:
-			position, tokenIndex = position173, tokenIndex173
-			return false
 		},
-		/* 19 Delimiter <- <(Question / And)> */
+		/* 184 Action15 <- <{ p.AddParam("con", text) }> */
 		func() bool {
-			position179, tokenIndex179 := position, tokenIndex
 			{
-				position180 := position
-				{
-					position181, tokenIndex181 := position, tokenIndex
-					if !_rules[ruleQuestion]() {
-						goto l182
-					}
-					goto l181
-				l182:
-					position, tokenIndex = position181, tokenIndex181
-					if !_rules[ruleAnd]() {
-						goto l179
-					}
-				}
-			l181:
-				add(ruleDelimiter, position180)
+				add(ruleAction15, position)
 			}
 			return true
-		l179:
-			position, tokenIndex = position179, tokenIndex179
-			return false
 		},
-		/* 20 Bool <- <(('t' 'r' 'u' 'e') / ('f' 'a' 'l' 's' 'e'))> */
+		/* 185 Action16 <- <{ p.AddParam("sat", text) }> */
 		func() bool {
-			position183, tokenIndex183 := position, tokenIndex
 			{
-				position184 := position
-				{
-					position185, tokenIndex185 := position, tokenIndex
-					if buffer[position] != rune('t') {
-						goto l186
-					}
-					position++
-					if buffer[position] != rune('r') {
-						goto l186
-					}
-					position++
-					if buffer[position] != rune('u') {
-						goto l186
-					}
-					position++
-					if buffer[position] != rune('e') {
-						goto l186
-					}
-					position++
-					goto l185
-				l186:
-					position, tokenIndex = position185, tokenIndex185
-					if buffer[position] != rune('f') {
-						goto l183
-					}
-					position++
-					if buffer[position] != rune('a') {
-						goto l183
-					}
-					position++
-					if buffer[position] != rune('l') {
-						goto l183
-					}
-					position++
-					if buffer[position] != rune('s') {
-						goto l183
-					}
-					position++
-					if buffer[position] != rune('e') {
-						goto l183
-					}
-					position++
-				}
-			l185:
-				add(ruleBool, position184)
+				add(ruleAction16, position)
 			}
 			return true
-		l183:
-			position, tokenIndex = position183, tokenIndex183
-			return false
 		},
-		/* 21 FitParam <- <(('c' 'l' 'i' 'p') / ('s' 'c' 'a' 'l' 'e') / ('m' 'a' 'x') / ('c' 'r' 'o' 'p'))> */
+		/* 186 Action17 <- <{ p.AddParam("gam", text) }> */
 		func() bool {
-			position187, tokenIndex187 := position, tokenIndex
 			{
-				position188 := position
-				{
-					position189, tokenIndex189 := position, tokenIndex
-					if buffer[position] != rune('c') {
-						goto l190
-					}
-					position++
-					if buffer[position] != rune('l') {
-						goto l190
-					}
-					position++
-					if buffer[position] != rune('i') {
-						goto l190
-					}
-					position++
-					if buffer[position] != rune('p') {
-						goto l190
-					}
-					position++
-					goto l189
-				l190:
-					position, tokenIndex = position189, tokenIndex189
-					if buffer[position] != rune('s') {
-						goto l191
-					}
-					position++
-					if buffer[position] != rune('c') {
-						goto l191
-					}
-					position++
-					if buffer[position] != rune('a') {
-						goto l191
-					}
-					position++
-					if buffer[position] != rune('l') {
-						goto l191
-					}
-					position++
-					if buffer[position] != rune('e') {
-						goto l191
-					}
-					position++
-					goto l189
-				l191:
-					position, tokenIndex = position189, tokenIndex189
-					if buffer[position] != rune('m') {
-						goto l192
-					}
-					position++
-					if buffer[position] != rune('a') {
-						goto l192
-					}
-					position++
-					if buffer[position] != rune('x') {
-						goto l192
-					}
-					position++
-					goto l189
-				l192:
-					position, tokenIndex = position189, tokenIndex189
-					if buffer[position] != rune('c') {
-						goto l187
-					}
-					position++
-					if buffer[position] != rune('r') {
-						goto l187
-					}
-					position++
-					if buffer[position] != rune('o') {
-						goto l187
-					}
-					position++
-					if buffer[position] != rune('p') {
-						goto l187
-					}
-					position++
-				}
-			l189:
-				add(ruleFitParam, position188)
+				add(ruleAction17, position)
 			}
 			return true
-		l187:
-			position, tokenIndex = position187, tokenIndex187
-			return false
 		},
-		/* 22 ReverseParam <- <(('f' 'l' 'i' 'p') / ('f' 'l' 'o' 'p'))> */
+		/* 187 Action18 <- <{ p.AddParam("hue", text) }> */
 		func() bool {
-			position193, tokenIndex193 := position, tokenIndex
 			{
-				position194 := position
-				{
-					position195, tokenIndex195 := position, tokenIndex
-					if buffer[position] != rune('f') {
-						goto l196
-					}
-					position++
-					if buffer[position] != rune('l') {
-						goto l196
-					}
-					position++
-					if buffer[position] != rune('i') {
-						goto l196
-					}
-					position++
-					if buffer[position] != rune('p') {
-						goto l196
-					}
-					position++
-					goto l195
-				l196:
-					position, tokenIndex = position195, tokenIndex195
-					if buffer[position] != rune('f') {
-						goto l193
-					}
-					position++
-					if buffer[position] != rune('l') {
-						goto l193
-					}
-					position++
-					if buffer[position] != rune('o') {
-						goto l193
-					}
-					position++
-					if buffer[position] != rune('p') {
-						goto l193
-					}
-					position++
-				}
-			l195:
-				add(ruleReverseParam, position194)
+				add(ruleAction18, position)
 			}
 			return true
-		l193:
-			position, tokenIndex = position193, tokenIndex193
-			return false
 		},
-		/* 23 Open <- <(Open_P / Open_B / Open_Box)> */
+		/* 188 Action19 <- <{ p.AddParam("mono", text) }> */
 		func() bool {
-			position197, tokenIndex197 := position, tokenIndex
 			{
-				position198 := position
-				{
-					position199, tokenIndex199 := position, tokenIndex
-					if !_rules[ruleOpen_P]() {
-						goto l200
-					}
-					goto l199
-				l200:
-					position, tokenIndex = position199, tokenIndex199
-					if !_rules[ruleOpen_B]() {
-						goto l201
-					}
-					goto l199
-				l201:
-					position, tokenIndex = position199, tokenIndex199
-					if !_rules[ruleOpen_Box]() {
-						goto l197
-					}
-				}
-			l199:
-				add(ruleOpen, position198)
+				add(ruleAction19, position)
 			}
 			return true
-		l197:
-			position, tokenIndex = position197, tokenIndex197
-			return false
 		},
-		/* 24 Close <- <(Close_P / Close_B / Close_Box)> */
+		/* 189 Action20 <- <{ p.AddParam("sepia", text) }> */
 		func() bool {
-			position202, tokenIndex202 := position, tokenIndex
 			{
-				position203 := position
-				{
-					position204, tokenIndex204 := position, tokenIndex
-					if !_rules[ruleClose_P]() {
-						goto l205
-					}
-					goto l204
-				l205:
-					position, tokenIndex = position204, tokenIndex204
-					if !_rules[ruleClose_B]() {
-						goto l206
-					}
-					goto l204
-				l206:
-					position, tokenIndex = position204, tokenIndex204
-					if !_rules[ruleClose_Box]() {
-						goto l202
-					}
-				}
-			l204:
-				add(ruleClose, position203)
+				add(ruleAction20, position)
 			}
 			return true
-		l202:
-			position, tokenIndex = position202, tokenIndex202
-			return false
 		},
-		/* 25 Digit <- <[0-9]+> */
+		/* 190 Action21 <- <{ p.AddParam("invert", text) }> */
 		func() bool {
-			position207, tokenIndex207 := position, tokenIndex
 			{
-				position208 := position
-				if c := buffer[position]; c < rune('0') || c > rune('9') {
-					goto l207
-				}
-				position++
-			l209:
-				{
-					position210, tokenIndex210 := position, tokenIndex
-					if c := buffer[position]; c < rune('0') || c > rune('9') {
-						goto l210
-					}
-					position++
-					goto l209
-				l210:
-					position, tokenIndex = position210, tokenIndex210
-				}
-				add(ruleDigit, position208)
+				add(ruleAction21, position)
 			}
 			return true
-		l207:
-			position, tokenIndex = position207, tokenIndex207
-			return false
 		},
-		/* 26 LowerCase <- <[a-z]+> */
+		/* 191 Action22 <- <{ p.AddParam("duotone", text) }> */
 		func() bool {
-			position211, tokenIndex211 := position, tokenIndex
 			{
-				position212 := position
-				if c := buffer[position]; c < rune('a') || c > rune('z') {
-					goto l211
-				}
-				position++
-			l213:
-				{
-					position214, tokenIndex214 := position, tokenIndex
-					if c := buffer[position]; c < rune('a') || c > rune('z') {
-						goto l214
-					}
-					position++
-					goto l213
-				l214:
-					position, tokenIndex = position214, tokenIndex214
-				}
-				add(ruleLowerCase, position212)
+				add(ruleAction22, position)
 			}
 			return true
-		l211:
-			position, tokenIndex = position211, tokenIndex211
-			return false
 		},
-		/* 27 All <- <([a-z] / [A-Z] / [0-9] / '_' / '*' / '{' / '}' / '(' / ')' / ',' / ':' / ';' / '%' / '#' / '=' / '/' / '.' / '-' / '+')+> */
+		/* 192 Action23 <- <{ p.AddParam("blur", text) }> */
 		func() bool {
-			position215, tokenIndex215 := position, tokenIndex
 			{
-				position216 := position
-				{
-					position219, tokenIndex219 := position, tokenIndex
-					if c := buffer[position]; c < rune('a') || c > rune('z') {
-						goto l220
-					}
-					position++
-					goto l219
-				l220:
-					position, tokenIndex = position219, tokenIndex219
-					if c := buffer[position]; c < rune('A') || c > rune('Z') {
-						goto l221
-					}
-					position++
-					goto l219
-				l221:
-					position, tokenIndex = position219, tokenIndex219
-					if c := buffer[position]; c < rune('0') || c > rune('9') {
-						goto l222
-					}
-					position++
-					goto l219
-				l222:
-					position, tokenIndex = position219, tokenIndex219
-					if buffer[position] != rune('_') {
-						goto l223
-					}
-					position++
-					goto l219
-				l223:
-					position, tokenIndex = position219, tokenIndex219
-					if buffer[position] != rune('*') {
-						goto l224
-					}
-					position++
-					goto l219
-				l224:
-					position, tokenIndex = position219, tokenIndex219
-					if buffer[position] != rune('{') {
-						goto l225
-					}
-					position++
-					goto l219
-				l225:
-					position, tokenIndex = position219, tokenIndex219
-					if buffer[position] != rune('}') {
-						goto l226
-					}
-					position++
-					goto l219
-				l226:
-					position, tokenIndex = position219, tokenIndex219
-					if buffer[position] != rune('(') {
-						goto l227
-					}
-					position++
-					goto l219
-				l227:
-					position, tokenIndex = position219, tokenIndex219
-					if buffer[position] != rune(')') {
-						goto l228
-					}
-					position++
-					goto l219
-				l228:
-					position, tokenIndex = position219, tokenIndex219
-					if buffer[position] != rune(',') {
-						goto l229
-					}
-					position++
-					goto l219
-				l229:
-					position, tokenIndex = position219, tokenIndex219
-					if buffer[position] != rune(':') {
-						goto l230
-					}
-					position++
-					goto l219
-				l230:
-					position, tokenIndex = position219, tokenIndex219
-					if buffer[position] != rune(';') {
-						goto l231
-					}
-					position++
-					goto l219
-				l231:
-					position, tokenIndex = position219, tokenIndex219
-					if buffer[position] != rune('%') {
-						goto l232
-					}
-					position++
-					goto l219
-				l232:
-					position, tokenIndex = position219, tokenIndex219
-					if buffer[position] != rune('#') {
-						goto l233
-					}
-					position++
-					goto l219
-				l233:
-					position, tokenIndex = position219, tokenIndex219
-					if buffer[position] != rune('=') {
-						goto l234
-					}
-					position++
-					goto l219
-				l234:
-					position, tokenIndex = position219, tokenIndex219
-					if buffer[position] != rune('/') {
-						goto l235
-					}
-					position++
-					goto l219
-				l235:
-					position, tokenIndex = position219, tokenIndex219
-					if buffer[position] != rune('.') {
-						goto l236
-					}
-					position++
-					goto l219
-				l236:
-					position, tokenIndex = position219, tokenIndex219
-					if buffer[position] != rune('-') {
-						goto l237
-					}
-					position++
-					goto l219
-				l237:
-					position, tokenIndex = position219, tokenIndex219
-					if buffer[position] != rune('+') {
-						goto l215
-					}
-					position++
-				}
-			l219:
-			l217:
-				{
-					position218, tokenIndex218 := position, tokenIndex
-					{
-						position238, tokenIndex238 := position, tokenIndex
-						if c := buffer[position]; c < rune('a') || c > rune('z') {
-							goto l239
-						}
-						position++
-						goto l238
-					l239:
-						position, tokenIndex = position238, tokenIndex238
-						if c := buffer[position]; c < rune('A') || c > rune('Z') {
-							goto l240
-						}
-						position++
-						goto l238
-					l240:
-						position, tokenIndex = position238, tokenIndex238
-						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l241
-						}
-						position++
-						goto l238
-					l241:
-						position, tokenIndex = position238, tokenIndex238
-						if buffer[position] != rune('_') {
-							goto l242
-						}
-						position++
-						goto l238
-					l242:
-						position, tokenIndex = position238, tokenIndex238
-						if buffer[position] != rune('*') {
-							goto l243
-						}
-						position++
-						goto l238
-					l243:
-						position, tokenIndex = position238, tokenIndex238
-						if buffer[position] != rune('{') {
-							goto l244
-						}
-						position++
-						goto l238
-					l244:
-						position, tokenIndex = position238, tokenIndex238
-						if buffer[position] != rune('}') {
-							goto l245
-						}
-						position++
-						goto l238
-					l245:
-						position, tokenIndex = position238, tokenIndex238
-						if buffer[position] != rune('(') {
-							goto l246
-						}
-						position++
-						goto l238
-					l246:
-						position, tokenIndex = position238, tokenIndex238
-						if buffer[position] != rune(')') {
-							goto l247
-						}
-						position++
-						goto l238
-					l247:
-						position, tokenIndex = position238, tokenIndex238
-						if buffer[position] != rune(',') {
-							goto l248
-						}
-						position++
-						goto l238
-					l248:
-						position, tokenIndex = position238, tokenIndex238
-						if buffer[position] != rune(':') {
-							goto l249
-						}
-						position++
-						goto l238
-					l249:
-						position, tokenIndex = position238, tokenIndex238
-						if buffer[position] != rune(';') {
-							goto l250
-						}
-						position++
-						goto l238
-					l250:
-						position, tokenIndex = position238, tokenIndex238
-						if buffer[position] != rune('%') {
-							goto l251
-						}
-						position++
-						goto l238
-					l251:
-						position, tokenIndex = position238, tokenIndex238
-						if buffer[position] != rune('#') {
-							goto l252
-						}
-						position++
-						goto l238
-					l252:
-						position, tokenIndex = position238, tokenIndex238
-						if buffer[position] != rune('=') {
-							goto l253
-						}
-						position++
-						goto l238
-					l253:
-						position, tokenIndex = position238, tokenIndex238
-						if buffer[position] != rune('/') {
-							goto l254
-						}
-						position++
-						goto l238
-					l254:
-						position, tokenIndex = position238, tokenIndex238
-						if buffer[position] != rune('.') {
-							goto l255
-						}
-						position++
-						goto l238
-					l255:
-						position, tokenIndex = position238, tokenIndex238
-						if buffer[position] != rune('-') {
-							goto l256
-						}
-						position++
-						goto l238
-					l256:
-						position, tokenIndex = position238, tokenIndex238
-						if buffer[position] != rune('+') {
-							goto l218
-						}
-						position++
-					}
-				l238:
-					goto l217
-				l218:
-					position, tokenIndex = position218, tokenIndex218
-				}
-				add(ruleAll, position216)
+				add(ruleAction23, position)
 			}
 			return true
-		l215:
-			position, tokenIndex = position215, tokenIndex215
-			return false
 		},
-		/* 28 Format_Key <- <('f' 'o' 'r' 'm' 'a' 't')> */
+		/* 193 Action24 <- <{ p.AddParam("sharp", text) }> */
 		func() bool {
-			position257, tokenIndex257 := position, tokenIndex
 			{
-				position258 := position
-				if buffer[position] != rune('f') {
-					goto l257
-				}
-				position++
-				if buffer[position] != rune('o') {
-					goto l257
-				}
-				position++
-				if buffer[position] != rune('r') {
-					goto l257
-				}
-				position++
-				if buffer[position] != rune('m') {
-					goto l257
-				}
-				position++
-				if buffer[position] != rune('a') {
-					goto l257
-				}
-				position++
-				if buffer[position] != rune('t') {
-					goto l257
-				}
-				position++
-				add(ruleFormat_Key, position258)
+				add(ruleAction24, position)
 			}
 			return true
-		l257:
-			position, tokenIndex = position257, tokenIndex257
-			return false
 		},
-		/* 29 Progressive_Key <- <('p' 'r' 'o' 'g' 'r' 'e' 's' 's' 'i' 'v' 'e')> */
+		/* 194 Action25 <- <{ p.AddParam("px", text) }> */
 		func() bool {
-			position259, tokenIndex259 := position, tokenIndex
 			{
-				position260 := position
-				if buffer[position] != rune('p') {
-					goto l259
-				}
-				position++
-				if buffer[position] != rune('r') {
-					goto l259
-				}
-				position++
-				if buffer[position] != rune('o') {
-					goto l259
-				}
-				position++
-				if buffer[position] != rune('g') {
-					goto l259
-				}
-				position++
-				if buffer[position] != rune('r') {
-					goto l259
-				}
-				position++
-				if buffer[position] != rune('e') {
-					goto l259
-				}
-				position++
-				if buffer[position] != rune('s') {
-					goto l259
-				}
-				position++
-				if buffer[position] != rune('s') {
-					goto l259
-				}
-				position++
-				if buffer[position] != rune('i') {
-					goto l259
-				}
-				position++
-				if buffer[position] != rune('v') {
-					goto l259
-				}
-				position++
-				if buffer[position] != rune('e') {
-					goto l259
-				}
-				position++
-				add(ruleProgressive_Key, position260)
+				add(ruleAction25, position)
+			}
+			return true
+		},
+		/* 195 Action26 <- <{ p.AddTupleListParam("redact") }> */
+		func() bool {
+			{
+				add(ruleAction26, position)
+			}
+			return true
+		},
+		/* 196 Action27 <- <{ p.AddParam("redact-mode", text) }> */
+		func() bool {
+			{
+				add(ruleAction27, position)
+			}
+			return true
+		},
+		/* 197 Action28 <- <{ p.AddParam("pad", text) }> */
+		func() bool {
+			{
+				add(ruleAction28, position)
+			}
+			return true
+		},
+		/* 198 Action29 <- <{ p.AddTupleParam("pad") }> */
+		func() bool {
+			{
+				add(ruleAction29, position)
+			}
+			return true
+		},
+		/* 199 Action30 <- <{ p.AddParam("border", text) }> */
+		func() bool {
+			{
+				add(ruleAction30, position)
+			}
+			return true
+		},
+		/* 200 Action31 <- <{ p.AddParam("mark", text) }> */
+		func() bool {
+			{
+				add(ruleAction31, position)
 			}
 			return true
-		l259:
-			position, tokenIndex = position259, tokenIndex259
-			return false
 		},
-		/* 30 Width_Key <- <(('w' 'i' 'd' 't' 'h') / 'w')> */
+		/* 201 Action32 <- <{ p.AddParam("mark-w", text) }> */
 		func() bool {
-			position261, tokenIndex261 := position, tokenIndex
 			{
-				position262 := position
-				{
-					position263, tokenIndex263 := position, tokenIndex
-					if buffer[position] != rune('w') {
-						goto l264
-					}
-					position++
-					if buffer[position] != rune('i') {
-						goto l264
-					}
-					position++
-					if buffer[position] != rune('d') {
-						goto l264
-					}
-					position++
-					if buffer[position] != rune('t') {
-						goto l264
-					}
-					position++
-					if buffer[position] != rune('h') {
-						goto l264
-					}
-					position++
-					goto l263
-				l264:
-					position, tokenIndex = position263, tokenIndex263
-					if buffer[position] != rune('w') {
-						goto l261
-					}
-					position++
-				}
-			l263:
-				add(ruleWidth_Key, position262)
+				add(ruleAction32, position)
 			}
 			return true
-		l261:
-			position, tokenIndex = position261, tokenIndex261
-			return false
 		},
-		/* 31 Height_Key <- <(('h' 'e' 'i' 'g' 'h' 't') / 'h')> */
+		/* 202 Action33 <- <{ p.AddParam("mark-align", text) }> */
 		func() bool {
-			position265, tokenIndex265 := position, tokenIndex
 			{
-				position266 := position
-				{
-					position267, tokenIndex267 := position, tokenIndex
-					if buffer[position] != rune('h') {
-						goto l268
-					}
-					position++
-					if buffer[position] != rune('e') {
-						goto l268
-					}
-					position++
-					if buffer[position] != rune('i') {
-						goto l268
-					}
-					position++
-					if buffer[position] != rune('g') {
-						goto l268
-					}
-					position++
-					if buffer[position] != rune('h') {
-						goto l268
-					}
-					position++
-					if buffer[position] != rune('t') {
-						goto l268
-					}
-					position++
-					goto l267
-				l268:
-					position, tokenIndex = position267, tokenIndex267
-					if buffer[position] != rune('h') {
-						goto l265
-					}
-					position++
-				}
-			l267:
-				add(ruleHeight_Key, position266)
+				add(ruleAction33, position)
 			}
 			return true
-		l265:
-			position, tokenIndex = position265, tokenIndex265
-			return false
 		},
-		/* 32 Fit_Key <- <('f' 'i' 't')> */
+		/* 203 Action34 <- <{ p.AddParam("mark-pad", text) }> */
 		func() bool {
-			position269, tokenIndex269 := position, tokenIndex
 			{
-				position270 := position
-				if buffer[position] != rune('f') {
-					goto l269
-				}
-				position++
-				if buffer[position] != rune('i') {
-					goto l269
-				}
-				position++
-				if buffer[position] != rune('t') {
-					goto l269
-				}
-				position++
-				add(ruleFit_Key, position270)
+				add(ruleAction34, position)
 			}
 			return true
-		l269:
-			position, tokenIndex = position269, tokenIndex269
-			return false
 		},
-		/* 33 Scale_Key <- <('s' 'c' 'a' 'l' 'e')> */
+		/* 204 Action35 <- <{ p.AddParam("mark-alpha", text) }> */
 		func() bool {
-			position271, tokenIndex271 := position, tokenIndex
 			{
-				position272 := position
-				if buffer[position] != rune('s') {
-					goto l271
-				}
-				position++
-				if buffer[position] != rune('c') {
-					goto l271
-				}
-				position++
-				if buffer[position] != rune('a') {
-					goto l271
-				}
-				position++
-				if buffer[position] != rune('l') {
-					goto l271
-				}
-				position++
-				if buffer[position] != rune('e') {
-					goto l271
-				}
-				position++
-				add(ruleScale_Key, position272)
+				add(ruleAction35, position)
 			}
 			return true
-		l271:
-			position, tokenIndex = position271, tokenIndex271
-			return false
 		},
-		/* 34 Reverse_Key <- <('r' 'e' 'v' 'e' 'r' 's' 'e')> */
+		/* 205 Action36 <- <{ p.AddParam("mark-scale", text) }> */
 		func() bool {
-			position273, tokenIndex273 := position, tokenIndex
 			{
-				position274 := position
-				if buffer[position] != rune('r') {
-					goto l273
-				}
-				position++
-				if buffer[position] != rune('e') {
-					goto l273
-				}
-				position++
-				if buffer[position] != rune('v') {
-					goto l273
-				}
-				position++
-				if buffer[position] != rune('e') {
-					goto l273
-				}
-				position++
-				if buffer[position] != rune('r') {
-					goto l273
-				}
-				position++
-				if buffer[position] != rune('s') {
-					goto l273
-				}
-				position++
-				if buffer[position] != rune('e') {
-					goto l273
-				}
-				position++
-				add(ruleReverse_Key, position274)
+				add(ruleAction36, position)
 			}
 			return true
-		l273:
-			position, tokenIndex = position273, tokenIndex273
-			return false
 		},
-		/* 35 Crop_Key <- <('c' 'r' 'o' 'p')> */
+		/* 206 Action37 <- <{ p.AddParam("txt", text) }> */
 		func() bool {
-			position275, tokenIndex275 := position, tokenIndex
 			{
-				position276 := position
-				if buffer[position] != rune('c') {
-					goto l275
-				}
-				position++
-				if buffer[position] != rune('r') {
-					goto l275
-				}
-				position++
-				if buffer[position] != rune('o') {
-					goto l275
-				}
-				position++
-				if buffer[position] != rune('p') {
-					goto l275
-				}
-				position++
-				add(ruleCrop_Key, position276)
+				add(ruleAction37, position)
 			}
 			return true
-		l275:
-			position, tokenIndex = position275, tokenIndex275
-			return false
 		},
-		/* 36 Quality_Key <- <(('q' 'u' 'a' 'l' 'i' 't' 'y') / 'q')> */
+		/* 207 Action38 <- <{ p.AddParam("txt-size", text) }> */
 		func() bool {
-			position277, tokenIndex277 := position, tokenIndex
 			{
-				position278 := position
-				{
-					position279, tokenIndex279 := position, tokenIndex
-					if buffer[position] != rune('q') {
-						goto l280
-					}
-					position++
-					if buffer[position] != rune('u') {
-						goto l280
-					}
-					position++
-					if buffer[position] != rune('a') {
-						goto l280
-					}
-					position++
-					if buffer[position] != rune('l') {
-						goto l280
-					}
-					position++
-					if buffer[position] != rune('i') {
-						goto l280
-					}
-					position++
-					if buffer[position] != rune('t') {
-						goto l280
-					}
-					position++
-					if buffer[position] != rune('y') {
-						goto l280
-					}
-					position++
-					goto l279
-				l280:
-					position, tokenIndex = position279, tokenIndex279
-					if buffer[position] != rune('q') {
-						goto l277
-					}
-					position++
-				}
-			l279:
-				add(ruleQuality_Key, position278)
+				add(ruleAction38, position)
 			}
 			return true
-		l277:
-			position, tokenIndex = position277, tokenIndex277
-			return false
 		},
-		/* 37 Exif_Key <- <('e' 'x' 'i' 'f')> */
+		/* 208 Action39 <- <{ p.AddParam("txt-color", text) }> */
 		func() bool {
-			position281, tokenIndex281 := position, tokenIndex
 			{
-				position282 := position
-				if buffer[position] != rune('e') {
-					goto l281
-				}
-				position++
-				if buffer[position] != rune('x') {
-					goto l281
-				}
-				position++
-				if buffer[position] != rune('i') {
-					goto l281
-				}
-				position++
-				if buffer[position] != rune('f') {
-					goto l281
-				}
-				position++
-				add(ruleExif_Key, position282)
+				add(ruleAction39, position)
 			}
 			return true
-		l281:
-			position, tokenIndex = position281, tokenIndex281
-			return false
 		},
-		/* 38 Equal <- <'='> */
+		/* 209 Action40 <- <{ p.AddParam("txt-align", text) }> */
 		func() bool {
-			position283, tokenIndex283 := position, tokenIndex
 			{
-				position284 := position
-				if buffer[position] != rune('=') {
-					goto l283
-				}
-				position++
-				add(ruleEqual, position284)
+				add(ruleAction40, position)
 			}
 			return true
-		l283:
-			position, tokenIndex = position283, tokenIndex283
-			return false
 		},
-		/* 39 Question <- <'?'> */
+		/* 210 Action41 <- <{ p.AddParam("txt-pad", text) }> */
 		func() bool {
-			position285, tokenIndex285 := position, tokenIndex
 			{
-				position286 := position
-				if buffer[position] != rune('?') {
-					goto l285
-				}
-				position++
-				add(ruleQuestion, position286)
+				add(ruleAction41, position)
 			}
 			return true
-		l285:
-			position, tokenIndex = position285, tokenIndex285
-			return false
 		},
-		/* 40 And <- <'&'> */
+		/* 211 Action42 <- <{ p.AddParam("txt-font", text) }> */
 		func() bool {
-			position287, tokenIndex287 := position, tokenIndex
 			{
-				position288 := position
-				if buffer[position] != rune('&') {
-					goto l287
-				}
-				position++
-				add(ruleAnd, position288)
+				add(ruleAction42, position)
 			}
 			return true
-		l287:
-			position, tokenIndex = position287, tokenIndex287
-			return false
 		},
-		/* 41 Dot <- <'.'> */
+		/* 212 Action43 <- <{ p.AddParam("corner-radius", text) }> */
 		func() bool {
-			position289, tokenIndex289 := position, tokenIndex
 			{
-				position290 := position
-				if buffer[position] != rune('.') {
-					goto l289
-				}
-				position++
-				add(ruleDot, position290)
+				add(ruleAction43, position)
 			}
 			return true
-		l289:
-			position, tokenIndex = position289, tokenIndex289
-			return false
 		},
-		/* 42 Comma <- <','> */
+		/* 213 Action44 <- <{ p.AddParam("mask", text) }> */
 		func() bool {
-			position291, tokenIndex291 := position, tokenIndex
 			{
-				position292 := position
-				if buffer[position] != rune(',') {
-					goto l291
-				}
-				position++
-				add(ruleComma, position292)
+				add(ruleAction44, position)
 			}
 			return true
-		l291:
-			position, tokenIndex = position291, tokenIndex291
-			return false
 		},
-		/* 43 Haihun <- <'-'> */
+		/* 214 Action45 <- <{ p.AddParam("trim", text) }> */
 		func() bool {
-			position293, tokenIndex293 := position, tokenIndex
 			{
-				position294 := position
-				if buffer[position] != rune('-') {
-					goto l293
-				}
-				position++
-				add(ruleHaihun, position294)
+				add(ruleAction45, position)
 			}
 			return true
-		l293:
-			position, tokenIndex = position293, tokenIndex293
-			return false
 		},
-		/* 44 Open_P <- <'('> */
+		/* 215 Action46 <- <{ p.AddParam("trim-tol", text) }> */
 		func() bool {
-			position295, tokenIndex295 := position, tokenIndex
 			{
-				position296 := position
-				if buffer[position] != rune('(') {
-					goto l295
-				}
-				position++
-				add(ruleOpen_P, position296)
+				add(ruleAction46, position)
 			}
 			return true
-		l295:
-			position, tokenIndex = position295, tokenIndex295
-			return false
 		},
-		/* 45 Close_P <- <')'> */
+		/* 216 Action47 <- <{ p.AddParam("trim-color", text) }> */
 		func() bool {
-			position297, tokenIndex297 := position, tokenIndex
 			{
-				position298 := position
-				if buffer[position] != rune(')') {
-					goto l297
-				}
-				position++
-				add(ruleClose_P, position298)
+				add(ruleAction47, position)
 			}
 			return true
-		l297:
-			position, tokenIndex = position297, tokenIndex297
-			return false
 		},
-		/* 46 Open_B <- <'{'> */
+		/* 217 Action48 <- <{ p.AddParam("filter", text) }> */
 		func() bool {
-			position299, tokenIndex299 := position, tokenIndex
 			{
-				position300 := position
-				if buffer[position] != rune('{') {
-					goto l299
-				}
-				position++
-				add(ruleOpen_B, position300)
+				add(ruleAction48, position)
 			}
 			return true
-		l299:
-			position, tokenIndex = position299, tokenIndex299
-			return false
 		},
-		/* 47 Close_B <- <'}'> */
+		/* 218 Action49 <- <{ p.AddParam("upscale", text) }> */
 		func() bool {
-			position301, tokenIndex301 := position, tokenIndex
 			{
-				position302 := position
-				if buffer[position] != rune('}') {
-					goto l301
-				}
-				position++
-				add(ruleClose_B, position302)
+				add(ruleAction49, position)
 			}
 			return true
-		l301:
-			position, tokenIndex = position301, tokenIndex301
-			return false
 		},
-		/* 48 Open_Box <- <'['> */
+		/* 219 Action50 <- <{ p.AddParam("palette", text) }> */
 		func() bool {
-			position303, tokenIndex303 := position, tokenIndex
 			{
-				position304 := position
-				if buffer[position] != rune('[') {
-					goto l303
-				}
-				position++
-				add(ruleOpen_Box, position304)
+				add(ruleAction50, position)
 			}
 			return true
-		l303:
-			position, tokenIndex = position303, tokenIndex303
-			return false
 		},
-		/* 49 Close_Box <- <']'> */
+		/* 220 Action51 <- <{ p.AddParam("colors", text) }> */
 		func() bool {
-			position305, tokenIndex305 := position, tokenIndex
 			{
-				position306 := position
-				if buffer[position] != rune(']') {
-					goto l305
-				}
-				position++
-				add(ruleClose_Box, position306)
+				add(ruleAction51, position)
 			}
 			return true
-		l305:
-			position, tokenIndex = position305, tokenIndex305
-			return false
 		},
-		/* 50 EOF <- <!.> */
+		/* 221 Action52 <- <{ p.AddParam("lqip", text) }> */
 		func() bool {
-			position307, tokenIndex307 := position, tokenIndex
 			{
-				position308 := position
-				{
-					position309, tokenIndex309 := position, tokenIndex
-					if !matchDot() {
-						goto l309
-					}
-					goto l307
-				l309:
-					position, tokenIndex = position309, tokenIndex309
-				}
-				add(ruleEOF, position308)
+				add(ruleAction52, position)
 			}
 			return true
-		l307:
-			position, tokenIndex = position307, tokenIndex307
-			return false
 		},
-		nil,
-		/* 53 Action0 <- <{ p.AddParam("format", text) }> */
+		/* 222 Action53 <- <{ p.AddParam("phash", text) }> */
 		func() bool {
 			{
-				add(ruleAction0, position)
+				add(ruleAction53, position)
 			}
 			return true
 		},
-		/* 54 Action1 <- <{ p.AddParam("progressive", text) }> */
+		/* 223 Action54 <- <{ p.AddParam("frame", text) }> */
 		func() bool {
 			{
-				add(ruleAction1, position)
+				add(ruleAction54, position)
 			}
 			return true
 		},
-		/* 55 Action2 <- <{ p.AddParam("width", text) }> */
+		/* 224 Action55 <- <{ p.AddParam("max-bytes", text) }> */
 		func() bool {
 			{
-				add(ruleAction2, position)
+				add(ruleAction55, position)
 			}
 			return true
 		},
-		/* 56 Action3 <- <{ p.AddParam("height", text) }> */
+		/* 225 Action56 <- <{ p.AddParam("lossless", text) }> */
 		func() bool {
 			{
-				add(ruleAction3, position)
+				add(ruleAction56, position)
 			}
 			return true
 		},
-		/* 57 Action4 <- <{ p.AddParam("fit", text) }> */
+		/* 226 Action57 <- <{ p.AddParam("dither", text) }> */
 		func() bool {
 			{
-				add(ruleAction4, position)
+				add(ruleAction57, position)
 			}
 			return true
 		},
-		/* 58 Action5 <- <{ p.AddParam("scale", text) }> */
+		/* 227 Action58 <- <{ p.AddParam("sign-separator", text) }> */
 		func() bool {
 			{
-				add(ruleAction5, position)
+				add(ruleAction58, position)
 			}
 			return true
 		},
-		/* 59 Action6 <- <{ p.AddParam("reverse", text) }> */
+		/* 228 Action59 <- <{ p.SkipParam(text) }> */
 		func() bool {
 			{
-				add(ruleAction6, position)
+				add(ruleAction59, position)
 			}
 			return true
 		},
-		/* 60 Action7 <- <{ p.AddCropSubParam("crop", "width", text) }> */
+		/* 229 Action60 <- <{ p.AddTupleSubParam("width", text) }> */
 		func() bool {
 			{
-				add(ruleAction7, position)
+				add(ruleAction60, position)
 			}
 			return true
 		},
-		/* 61 Action8 <- <{ p.AddCropSubParam("crop", "height", text) }> */
+		/* 230 Action61 <- <{ p.AddTupleSubParam("height", text) }> */
 		func() bool {
 			{
-				add(ruleAction8, position)
+				add(ruleAction61, position)
 			}
 			return true
 		},
-		/* 62 Action9 <- <{ p.AddCropSubParam("crop", "x", text) }> */
+		/* 231 Action62 <- <{ p.AddTupleSubParam("x", text) }> */
 		func() bool {
 			{
-				add(ruleAction9, position)
+				add(ruleAction62, position)
 			}
 			return true
 		},
-		/* 63 Action10 <- <{ p.AddCropSubParam("crop", "y", text) }> */
+		/* 232 Action63 <- <{ p.AddTupleSubParam("y", text) }> */
 		func() bool {
 			{
-				add(ruleAction10, position)
+				add(ruleAction63, position)
 			}
 			return true
 		},
-		/* 64 Action11 <- <{ p.AddParam("quality", text) }> */
+		/* 233 Action64 <- <{ p.AddTupleSubParam("top", text) }> */
 		func() bool {
 			{
-				add(ruleAction11, position)
+				add(ruleAction64, position)
 			}
 			return true
 		},
-		/* 65 Action12 <- <{ p.AddParam("exif", text) }> */
+		/* 234 Action65 <- <{ p.AddTupleSubParam("right", text) }> */
 		func() bool {
 			{
-				add(ruleAction12, position)
+				add(ruleAction65, position)
 			}
 			return true
 		},
-		/* 66 Action13 <- <{ p.SkipParam(text) }> */
+		/* 235 Action66 <- <{ p.AddTupleSubParam("bottom", text) }> */
 		func() bool {
 			{
-				add(ruleAction13, position)
+				add(ruleAction66, position)
+			}
+			return true
+		},
+		/* 236 Action67 <- <{ p.AddTupleSubParam("left", text) }> */
+		func() bool {
+			{
+				add(ruleAction67, position)
 			}
 			return true
 		},
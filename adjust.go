@@ -0,0 +1,88 @@
+package main
+
+import (
+	"image"
+	"image/draw"
+	"math"
+)
+
+// Adjust applies the color adjustments of o to img. The order is fixed:
+// bri, con and gam per channel, then sat and hue as one color matrix.
+func Adjust(img image.Image, o *Options) image.Image {
+	if o.Brightness == 0 && o.Contrast == 0 && o.Gamma == 0 && o.Saturation == 0 && o.Hue == 0 {
+		return img
+	}
+	dst := toNRGBA(img)
+
+	var lut [256]uint8
+	bri := 255 * float64(o.Brightness) / 100
+	con := 1 + float64(o.Contrast)/100
+	if o.Contrast > 0 {
+		con = 1 / math.Max(1-float64(o.Contrast)/100, 1.0/255)
+	}
+	gam := math.Pow(2, -float64(o.Gamma)/50)
+	for i := range lut {
+		v := float64(i) + bri
+		v = (v-127.5)*con + 127.5
+		v = 255 * math.Pow(clamp(v, 0, 255)/255, gam)
+		lut[i] = uint8(clamp(v+0.5, 0, 255))
+	}
+
+	m := colorMatrix(1+float64(o.Saturation)/100, float64(o.Hue)*1.8)
+	matrix := o.Saturation != 0 || o.Hue != 0
+
+	for i := 0; i < len(dst.Pix); i += 4 {
+		r, g, b := lut[dst.Pix[i]], lut[dst.Pix[i+1]], lut[dst.Pix[i+2]]
+		if matrix {
+			fr, fg, fb := float64(r), float64(g), float64(b)
+			r = uint8(clamp(m[0]*fr+m[1]*fg+m[2]*fb+0.5, 0, 255))
+			g = uint8(clamp(m[3]*fr+m[4]*fg+m[5]*fb+0.5, 0, 255))
+			b = uint8(clamp(m[6]*fr+m[7]*fg+m[8]*fb+0.5, 0, 255))
+		}
+		dst.Pix[i], dst.Pix[i+1], dst.Pix[i+2] = r, g, b
+	}
+	return dst
+}
+
+// colorMatrix combines a saturation factor and a hue rotation in degrees,
+// as the feColorMatrix saturate and hueRotate filters define them.
+func colorMatrix(sat, deg float64) [9]float64 {
+	s := [9]float64{
+		0.213 + 0.787*sat, 0.715 - 0.715*sat, 0.072 - 0.072*sat,
+		0.213 - 0.213*sat, 0.715 + 0.285*sat, 0.072 - 0.072*sat,
+		0.213 - 0.213*sat, 0.715 - 0.715*sat, 0.072 + 0.928*sat,
+	}
+	sin, cos := math.Sincos(deg * math.Pi / 180)
+	h := [9]float64{
+		0.213 + cos*0.787 - sin*0.213, 0.715 - cos*0.715 - sin*0.715, 0.072 - cos*0.072 + sin*0.928,
+		0.213 - cos*0.213 + sin*0.143, 0.715 + cos*0.285 + sin*0.140, 0.072 - cos*0.072 - sin*0.283,
+		0.213 - cos*0.213 - sin*0.787, 0.715 - cos*0.715 + sin*0.715, 0.072 + cos*0.928 + sin*0.072,
+	}
+	var m [9]float64
+	for r := 0; r < 3; r++ {
+		for c := 0; c < 3; c++ {
+			for k := 0; k < 3; k++ {
+				m[r*3+c] += h[r*3+k] * s[k*3+c]
+			}
+		}
+	}
+	return m
+}
+
+// toNRGBA returns a copy of img as *image.NRGBA with its origin at 0,0.
+func toNRGBA(img image.Image) *image.NRGBA {
+	b := img.Bounds()
+	dst := image.NewNRGBA(image.Rect(0, 0, b.Dx(), b.Dy()))
+	draw.Draw(dst, dst.Bounds(), img, b.Min, draw.Src)
+	return dst
+}
+
+func clamp(v, min, max float64) float64 {
+	if v < min {
+		return min
+	}
+	if v > max {
+		return max
+	}
+	return v
+}
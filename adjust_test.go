@@ -0,0 +1,82 @@
+package main
+
+import (
+	"image"
+	"image/color"
+	"testing"
+)
+
+func TestOptionsSigned(t *testing.T) {
+	tests := []struct {
+		query string
+		bri   int
+		ok    bool
+	}{
+		{"?bri=50", 50, true},
+		{"?bri=-50", -50, true},
+		{"?bri-50", 0, false},
+		{"?bri--50", 0, false},
+		{"?bri.50", 0, false},
+		{"?bri,-50", 0, false},
+		{"?bri=-101", 0, false},
+	}
+	for _, tt := range tests {
+		o, err := parseOptions(tt.query)
+		if !tt.ok {
+			if err == nil {
+				t.Errorf("%s: got bri %d, want an error", tt.query, o.Brightness)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: %v", tt.query, err)
+			continue
+		}
+		if o.Brightness != tt.bri {
+			t.Errorf("%s: got bri %d, want %d", tt.query, o.Brightness, tt.bri)
+		}
+	}
+	for _, key := range []string{"con", "sat", "gam", "hue"} {
+		if _, err := parseOptions("?" + key + "-20"); err == nil {
+			t.Errorf("%s-20: want an error", key)
+		}
+	}
+}
+
+func TestAdjust(t *testing.T) {
+	gray := color.NRGBA{100, 100, 100, 255}
+	orange := color.NRGBA{255, 160, 40, 200}
+	tests := []struct {
+		name string
+		o    Options
+		in   color.NRGBA
+		ok   func(c color.NRGBA) bool
+	}{
+		{"bri up", Options{Brightness: 20}, gray, func(c color.NRGBA) bool { return c.R == 151 && c.G == 151 && c.B == 151 }},
+		{"bri down", Options{Brightness: -20}, gray, func(c color.NRGBA) bool { return c.R == 49 }},
+		{"con up", Options{Contrast: 50}, gray, func(c color.NRGBA) bool { return c.R == 73 }},
+		{"con -100", Options{Contrast: -100}, orange, func(c color.NRGBA) bool { return c.R == 128 && c.G == 128 && c.B == 128 }},
+		{"gam up", Options{Gamma: 50}, gray, func(c color.NRGBA) bool { return c.R > 100 }},
+		{"gam down", Options{Gamma: -50}, gray, func(c color.NRGBA) bool { return c.R < 100 }},
+		{"sat -100", Options{Saturation: -100}, orange, func(c color.NRGBA) bool { return c.R == c.G && c.G == c.B }},
+		{"sat up", Options{Saturation: 50}, color.NRGBA{200, 150, 100, 255}, func(c color.NRGBA) bool { return c.R-c.B > 100 }},
+		{"hue 180", Options{Hue: 100}, color.NRGBA{255, 0, 0, 255}, func(c color.NRGBA) bool { return c.R < c.G && c.R < c.B }},
+	}
+	for _, tt := range tests {
+		out := Adjust(uniform(4, 4, tt.in), &tt.o)
+		c := color.NRGBAModel.Convert(out.At(1, 1)).(color.NRGBA)
+		if !tt.ok(c) {
+			t.Errorf("%s: %v became %v", tt.name, tt.in, c)
+		}
+		if c.A != tt.in.A {
+			t.Errorf("%s: alpha %d became %d", tt.name, tt.in.A, c.A)
+		}
+	}
+}
+
+func TestAdjustNoop(t *testing.T) {
+	var img image.Image = uniform(4, 4, color.NRGBA{1, 2, 3, 255})
+	if out := Adjust(img, &Options{}); out != img {
+		t.Error("Adjust without adjustments copied the image")
+	}
+}
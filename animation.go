@@ -0,0 +1,145 @@
+package main
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/gif"
+)
+
+// TransformGIF runs Transform on every frame of g as it shows on screen,
+// after the frames before it have been drawn and disposed of, so every
+// parameter applies to animations as it does to stills. The results are
+// written as whole frames on one palette of 256 colors, or colors, and
+// keep their delays and the loop count.
+func (e *Engine) TransformGIF(g *gif.GIF, o *Options) (*gif.GIF, error) {
+	var frames []*image.NRGBA
+	var bounds image.Rectangle
+	err := composite(g, len(g.Image), func(screen *image.NRGBA) error {
+		img, err := e.Transform(screen, o)
+		if err != nil {
+			return err
+		}
+		frame := toNRGBA(img)
+		frames = append(frames, frame)
+		bounds = bounds.Union(frame.Rect)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	n := 256
+	if o.Quantized() {
+		n = o.Colors
+	}
+	palette := quantizePalette(frames, n)
+	out := &gif.GIF{
+		Delay:     g.Delay,
+		LoopCount: g.LoopCount,
+		Config: image.Config{
+			ColorModel: palette,
+			Width:      bounds.Dx(),
+			Height:     bounds.Dy(),
+		},
+	}
+	// Frames with transparent pixels must not show the one before.
+	disposal := byte(gif.DisposalNone)
+	if i := transparentIndex(palette); i >= 0 {
+		disposal = gif.DisposalBackground
+		out.BackgroundIndex = byte(i)
+	}
+	for _, frame := range frames {
+		out.Image = append(out.Image, remap(frame, palette, o.Dither))
+		out.Disposal = append(out.Disposal, disposal)
+	}
+	return out, nil
+}
+
+// Still returns the source reduced to frame n, counted from 1. A still
+// image has just the one frame.
+func (s *Source) Still(n int) (*Source, error) {
+	if s.Anim == nil {
+		if n != 1 {
+			return nil, fmt.Errorf("frame: %d is out of range 1..1", n)
+		}
+		return s, nil
+	}
+	img, err := StillFrame(s.Anim, n)
+	if err != nil {
+		return nil, err
+	}
+	still := *s
+	still.Image, still.Anim = img, nil
+	return &still, nil
+}
+
+// StillFrame returns frame n, counted from 1, as it appears on screen
+// once the frames before it have been drawn and disposed of.
+func StillFrame(g *gif.GIF, n int) (image.Image, error) {
+	if n < 1 || n > len(g.Image) {
+		return nil, fmt.Errorf("frame: %d is out of range 1..%d", n, len(g.Image))
+	}
+	var still image.Image
+	composite(g, n, func(screen *image.NRGBA) error {
+		still = screen
+		return nil
+	})
+	return still, nil
+}
+
+// composite draws the first n frames of g in turn and calls fn with the
+// screen after each, before that frame is disposed of. fn must not keep
+// the screen, except after the last frame.
+func composite(g *gif.GIF, n int, fn func(screen *image.NRGBA) error) error {
+	screen := image.NewNRGBA(gifBounds(g))
+	for i, frame := range g.Image[:n] {
+		var previous *image.NRGBA
+		disposal := byte(0)
+		if i < len(g.Disposal) {
+			disposal = g.Disposal[i]
+		}
+		if disposal == gif.DisposalPrevious {
+			previous = toNRGBA(screen)
+		}
+		draw.Draw(screen, frame.Rect, frame, frame.Rect.Min, draw.Over)
+		if err := fn(screen); err != nil {
+			return err
+		}
+		if i == n-1 {
+			break
+		}
+		switch disposal {
+		case gif.DisposalBackground:
+			draw.Draw(screen, frame.Rect, image.Transparent, image.Point{}, draw.Src)
+		case gif.DisposalPrevious:
+			draw.Draw(screen, screen.Rect, previous, image.Point{}, draw.Src)
+		}
+	}
+	return nil
+}
+
+// gifBounds is the logical screen of g, or the union of its frames when
+// the header leaves it out.
+func gifBounds(g *gif.GIF) image.Rectangle {
+	if g.Config.Width > 0 && g.Config.Height > 0 {
+		return image.Rect(0, 0, g.Config.Width, g.Config.Height)
+	}
+	var r image.Rectangle
+	for _, frame := range g.Image {
+		r = r.Union(frame.Rect)
+	}
+	return r
+}
+
+// transparentIndex returns the first fully transparent entry of
+// palette, or -1.
+func transparentIndex(palette color.Palette) int {
+	for i, c := range palette {
+		if _, _, _, a := c.RGBA(); a == 0 {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,167 @@
+package main
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/gif"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+var (
+	red   = color.NRGBA{0xff, 0, 0, 0xff}
+	green = color.NRGBA{0, 0xff, 0, 0xff}
+	blue  = color.NRGBA{0, 0, 0xff, 0xff}
+)
+
+// testGIF is a 40x30 animation of three frames: red everywhere, a green
+// square that is cleared again, and a blue bar left in place.
+func testGIF() *gif.GIF {
+	palette := color.Palette{red, green, blue, color.Transparent}
+	frame := func(r image.Rectangle, index uint8) *image.Paletted {
+		p := image.NewPaletted(r, palette)
+		for i := range p.Pix {
+			p.Pix[i] = index
+		}
+		return p
+	}
+	return &gif.GIF{
+		Image: []*image.Paletted{
+			frame(image.Rect(0, 0, 40, 30), 0),
+			frame(image.Rect(10, 5, 30, 25), 1),
+			frame(image.Rect(0, 20, 40, 30), 2),
+		},
+		Delay:    []int{10, 20, 30},
+		Disposal: []byte{gif.DisposalNone, gif.DisposalBackground, gif.DisposalNone},
+		Config:   image.Config{ColorModel: palette, Width: 40, Height: 30},
+	}
+}
+
+// animationHandler serves testGIF as anim.gif and a mark image as m.png.
+func animationHandler(t *testing.T) *Handler {
+	t.Helper()
+	dir := t.TempDir()
+	f, err := os.Create(filepath.Join(dir, "anim.gif"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	if err := gif.EncodeAll(f, testGIF()); err != nil {
+		t.Fatal(err)
+	}
+	writePNG(t, dir, "m.png", uniform(8, 8, color.NRGBA{0xff, 0xff, 0xff, 0xff}))
+	return &Handler{Engine: &Engine{Config: DefaultConfig, Origin: Dir(dir)}}
+}
+
+func get(t *testing.T, h *Handler, url string) *httptest.ResponseRecorder {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest("GET", url, nil))
+	return rec
+}
+
+func getGIF(t *testing.T, h *Handler, url string) (*gif.GIF, []byte) {
+	t.Helper()
+	rec := get(t, h, url)
+	if rec.Code != 200 {
+		t.Fatalf("%s: status %d: %s", url, rec.Code, rec.Body)
+	}
+	g, err := gif.DecodeAll(bytes.NewReader(rec.Body.Bytes()))
+	if err != nil {
+		t.Fatalf("%s: %v", url, err)
+	}
+	return g, rec.Body.Bytes()
+}
+
+// screens returns every frame of g as it shows on screen.
+func screens(t *testing.T, g *gif.GIF) []*image.NRGBA {
+	var out []*image.NRGBA
+	for n := 1; n <= len(g.Image); n++ {
+		img, err := StillFrame(g, n)
+		if err != nil {
+			t.Fatal(err)
+		}
+		out = append(out, toNRGBA(img))
+	}
+	return out
+}
+
+func TestStillFrameDisposal(t *testing.T) {
+	s := screens(t, testGIF())
+	if c := s[1].NRGBAAt(15, 10); c != green {
+		t.Errorf("frame 2 at (15,10) is %v, want green", c)
+	}
+	// The green square is cleared to transparent before frame 3.
+	if c := s[2].NRGBAAt(15, 10); c.A != 0 {
+		t.Errorf("frame 3 at (15,10) is %v, want transparent", c)
+	}
+	if c := s[2].NRGBAAt(5, 10); c != red {
+		t.Errorf("frame 3 at (5,10) is %v, want red", c)
+	}
+	if c := s[2].NRGBAAt(5, 25); c != blue {
+		t.Errorf("frame 3 at (5,25) is %v, want blue", c)
+	}
+}
+
+func TestAnimationKeepsTiming(t *testing.T) {
+	g, _ := getGIF(t, animationHandler(t), "/anim.gif?w=20")
+	if len(g.Image) != 3 || g.Delay[0] != 10 || g.Delay[1] != 20 || g.Delay[2] != 30 {
+		t.Fatalf("%d frames with delays %v, want 3 with [10 20 30]", len(g.Image), g.Delay)
+	}
+	if g.Config.Width != 20 || g.Config.Height != 15 {
+		t.Errorf("size %dx%d, want 20x15", g.Config.Width, g.Config.Height)
+	}
+	s := screens(t, g)
+	if c := s[2].NRGBAAt(2, 12); c != blue {
+		t.Errorf("frame 3 at (2,12) is %v, want blue", c)
+	}
+}
+
+// TestAnimationRedact checks the redacted region is hidden in every
+// frame, which decides whether redact is safe on animations.
+func TestAnimationRedact(t *testing.T) {
+	g, _ := getGIF(t, animationHandler(t), "/anim.gif?redact(x0,y0,w40,h12)")
+	for i, s := range screens(t, g) {
+		for y := 0; y < 12; y++ {
+			for x := 0; x < 40; x++ {
+				if c := s.NRGBAAt(x, y); c != (color.NRGBA{0, 0, 0, 0xff}) {
+					t.Fatalf("frame %d at (%d,%d) is %v, want black", i+1, x, y, c)
+				}
+			}
+		}
+	}
+}
+
+func TestAnimationStages(t *testing.T) {
+	h := animationHandler(t)
+	_, plain := getGIF(t, h, "/anim.gif")
+	for _, query := range []string{"mark=m.png&mark-pad=0", "txt=hi", "blur=40", "mono=true", "mask=circle", "pad=5&bg=0000ff", "colors=2"} {
+		g, data := getGIF(t, h, "/anim.gif?"+query)
+		if bytes.Equal(data, plain) {
+			t.Errorf("%s: output is the same as without it", query)
+		}
+		if len(g.Image) != 3 {
+			t.Errorf("%s: %d frames, want 3", query, len(g.Image))
+		}
+	}
+
+	g, _ := getGIF(t, h, "/anim.gif?mask=circle")
+	for i, s := range screens(t, g) {
+		if c := s.NRGBAAt(0, 0); c.A != 0 {
+			t.Errorf("mask=circle: frame %d corner is %v, want transparent", i+1, c)
+		}
+	}
+	g, _ = getGIF(t, h, "/anim.gif?pad=5&bg=0000ff")
+	if g.Config.Width != 50 || g.Config.Height != 40 {
+		t.Errorf("pad=5: size %dx%d, want 50x40", g.Config.Width, g.Config.Height)
+	}
+	g, _ = getGIF(t, h, "/anim.gif?colors=2")
+	for i, frame := range g.Image {
+		if len(frame.Palette) > 2 {
+			t.Errorf("colors=2: frame %d has %d colors", i+1, len(frame.Palette))
+		}
+	}
+}
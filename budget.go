@@ -0,0 +1,83 @@
+package main
+
+import (
+	"fmt"
+	"image"
+)
+
+const (
+	// budgetShrink scales the image down each time max-bytes cannot be
+	// met at the lowest quality.
+	budgetShrink = 0.8
+	// budgetShrinks bounds how often that happens.
+	budgetShrinks = 8
+)
+
+// fitBudget encodes img with the highest quality whose output has at
+// most o.MaxBytes bytes, searching from 1 up to the explicit quality or
+// DefaultQuality. When quality 1 does not fit, or f has no quality, img
+// is scaled down with filter and tried again. o.Quality is set to the
+// quality used.
+func fitBudget(img image.Image, o *Options, f *Format, filter string, encode func(image.Image, *Options) ([]byte, error)) ([]byte, error) {
+	upper := o.Quality
+	if upper == 0 {
+		upper = DefaultQuality
+	}
+	for shrink := 0; ; shrink++ {
+		q, data, err := searchQuality(img, o, f, upper, encode)
+		if err != nil {
+			return nil, err
+		}
+		if data != nil {
+			if f.Quality {
+				o.Quality = q
+			}
+			return data, nil
+		}
+
+		size := img.Bounds().Size()
+		smaller := roundPoint(float64(size.X)*budgetShrink, float64(size.Y)*budgetShrink)
+		if shrink == budgetShrinks || smaller == size {
+			return nil, fmt.Errorf("max-bytes: %s output does not fit in %d bytes", f.Name, o.MaxBytes)
+		}
+		img = Resize(img, smaller, filter)
+	}
+}
+
+// searchQuality binary searches 1..upper for the highest quality that
+// fits. It returns nil data when none does.
+func searchQuality(img image.Image, o *Options, f *Format, upper int, encode func(image.Image, *Options) ([]byte, error)) (int, []byte, error) {
+	try := func(q int) ([]byte, error) {
+		with := *o
+		with.Quality = q
+		data, err := encode(img, &with)
+		if err != nil || len(data) > o.MaxBytes {
+			return nil, err
+		}
+		return data, nil
+	}
+	if !f.Quality {
+		data, err := try(o.Quality)
+		return o.Quality, data, err
+	}
+
+	best, bestData := 0, []byte(nil)
+	lo, hi := 1, upper
+	for lo <= hi {
+		mid := (lo + hi + 1) / 2
+		if best == 0 && lo == 1 && hi == upper {
+			mid = upper
+		}
+		data, err := try(mid)
+		if err != nil {
+			return 0, nil, err
+		}
+		if data != nil {
+			best, bestData = mid, data
+			lo = mid + 1
+		} else {
+			hi = mid - 1
+		}
+	}
+	return best, bestData, nil
+}
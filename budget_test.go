@@ -0,0 +1,118 @@
+package main
+
+import (
+	"bytes"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"strconv"
+	"testing"
+)
+
+// noisy is a w by h image that compresses poorly.
+func noisy(w, h int) *image.NRGBA {
+	img := toNRGBA(Flatten(testImage(w, h), nil))
+	seed := uint32(7)
+	for i := range img.Pix {
+		if i%4 == 3 {
+			continue
+		}
+		seed = seed*1664525 + 1013904223
+		img.Pix[i] = img.Pix[i]/2 + uint8(seed>>25)
+	}
+	return img
+}
+
+func renderBudget(t *testing.T, query string, img image.Image) ([]byte, *Options, error) {
+	t.Helper()
+	o, err := parseOptions(query)
+	if err != nil {
+		t.Fatal(err)
+	}
+	e := &Engine{Config: DefaultConfig}
+	var buf bytes.Buffer
+	_, err = e.Render(&buf, &Source{Image: img, Format: "png"}, o)
+	return buf.Bytes(), o, err
+}
+
+func TestMaxBytesQuality(t *testing.T) {
+	src := noisy(160, 120)
+	for _, budget := range []int{4000, 8000, 15000, 30000, 1 << 20} {
+		for _, q := range []int{0, 30, 90} {
+			query := "?format=jpg&max-bytes=" + strconv.Itoa(budget)
+			limit := DefaultQuality
+			if q > 0 {
+				query += "&q=" + strconv.Itoa(q)
+				limit = q
+			}
+			data, o, err := renderBudget(t, query, src)
+			if err != nil {
+				t.Fatalf("%s: %v", query, err)
+			}
+			if len(data) > budget {
+				t.Errorf("%s: %d bytes", query, len(data))
+			}
+			if o.Quality < 1 || o.Quality > limit {
+				t.Errorf("%s: quality %d, want 1..%d", query, o.Quality, limit)
+			}
+			if budget == 1<<20 && o.Quality != limit {
+				t.Errorf("%s: quality %d with room to spare, want %d", query, o.Quality, limit)
+			}
+			img, err := jpeg.Decode(bytes.NewReader(data))
+			if err != nil {
+				t.Fatalf("%s: %v", query, err)
+			}
+			if img.Bounds().Size() != src.Rect.Size() {
+				t.Errorf("%s: downscaled to %v although a quality fits", query, img.Bounds().Size())
+			}
+		}
+	}
+}
+
+func TestMaxBytesDownscale(t *testing.T) {
+	src := noisy(160, 120)
+	data, _, err := renderBudget(t, "?format=jpg&max-bytes=900", src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	img, err := jpeg.Decode(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(data) > 900 || img.Bounds().Dx() >= 160 {
+		t.Errorf("%d bytes at %v, want at most 900 and smaller", len(data), img.Bounds().Size())
+	}
+
+	// png has no quality, so only downscaling helps.
+	data, _, err = renderBudget(t, "?format=png&max-bytes=20000", src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	img, err = png.Decode(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(data) > 20000 || img.Bounds().Dx() >= 160 {
+		t.Errorf("png: %d bytes at %v, want at most 20000 and smaller", len(data), img.Bounds().Size())
+	}
+
+	if _, _, err := renderBudget(t, "?format=jpg&max-bytes=50", src); err == nil {
+		t.Error("max-bytes=50: got no error")
+	}
+}
+
+func TestMaxBytesHandler(t *testing.T) {
+	h := animationHandler(t)
+	rec := get(t, h, "/anim.gif?max-bytes=100")
+	if rec.Code != 400 {
+		t.Errorf("animated max-bytes: status %d, want 400", rec.Code)
+	}
+	rec = get(t, h, "/anim.gif?frame=2&format=jpg&max-bytes=2000&q=60")
+	if rec.Code != 200 || rec.Body.Len() > 2000 {
+		t.Fatalf("still max-bytes: status %d, %d bytes", rec.Code, rec.Body.Len())
+	}
+	q, err := strconv.Atoi(rec.Header().Get("X-Image-Quality"))
+	if err != nil || q < 1 || q > 60 {
+		t.Errorf("X-Image-Quality %q, want 1..60", rec.Header().Get("X-Image-Quality"))
+	}
+}
@@ -0,0 +1,47 @@
+package main
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+)
+
+// Frame places img, already resized to l.Size, on a canvas of l.Canvas.
+// The canvas is filled with bg, or left transparent, and the border is
+// drawn along its edges.
+func Frame(img image.Image, l Layout, o *Options) image.Image {
+	if l.Canvas == l.Size && l.Border == 0 {
+		return img
+	}
+	dst := image.NewNRGBA(image.Rect(0, 0, l.Canvas.X, l.Canvas.Y))
+	if o.Background != nil {
+		draw.Draw(dst, dst.Rect, image.NewUniform(o.Background), image.Point{}, draw.Src)
+	}
+	if l.Border > 0 {
+		border := image.NewUniform(o.BorderColor)
+		inner := dst.Rect.Inset(l.Border)
+		for _, r := range []image.Rectangle{
+			image.Rect(0, 0, l.Canvas.X, inner.Min.Y),
+			image.Rect(0, inner.Max.Y, l.Canvas.X, l.Canvas.Y),
+			image.Rect(0, inner.Min.Y, inner.Min.X, inner.Max.Y),
+			image.Rect(inner.Max.X, inner.Min.Y, l.Canvas.X, inner.Max.Y),
+		} {
+			draw.Draw(dst, r, border, image.Point{}, draw.Src)
+		}
+	}
+	r := image.Rectangle{l.Offset, l.Offset.Add(l.Size)}
+	draw.Draw(dst, r, img, img.Bounds().Min, draw.Over)
+	return dst
+}
+
+// Flatten draws img over bg, or over white when bg is nil.
+func Flatten(img image.Image, bg color.Color) image.Image {
+	if bg == nil {
+		bg = color.White
+	}
+	b := img.Bounds()
+	dst := image.NewNRGBA(image.Rect(0, 0, b.Dx(), b.Dy()))
+	draw.Draw(dst, dst.Rect, image.NewUniform(bg), image.Point{}, draw.Src)
+	draw.Draw(dst, dst.Rect, img, b.Min, draw.Over)
+	return dst
+}
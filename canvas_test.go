@@ -0,0 +1,67 @@
+package main
+
+import (
+	"image"
+	"image/color"
+	"testing"
+)
+
+func TestFrame(t *testing.T) {
+	e := &Engine{Config: DefaultConfig}
+	o, err := parseOptions("?w=48&h=36&pad(t2,r6,b4,l8)&border=3,ff0000&bg=0000ff")
+	if err != nil {
+		t.Fatal(err)
+	}
+	img, err := e.Transform(testImage(40, 30), o)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// pad and border are carved out of the 48x36 canvas, leaving 28x24
+	// for the image, which letterboxes to 28x21.
+	if size := img.Bounds().Size(); size != image.Pt(48, 36) {
+		t.Fatalf("size %v, want 48x36", size)
+	}
+	checkGolden(t, "frame", img)
+
+	got := toNRGBA(img)
+	red, blue := color.NRGBA{255, 0, 0, 255}, color.NRGBA{0, 0, 255, 255}
+	for _, tt := range []struct {
+		p    image.Point
+		want color.NRGBA
+	}{
+		{image.Pt(0, 0), red},
+		{image.Pt(47, 35), red},
+		{image.Pt(2, 18), red},
+		{image.Pt(3, 3), blue},
+		{image.Pt(10, 4), blue},
+		{image.Pt(42, 18), blue},
+		{image.Pt(25, 30), blue},
+		{image.Pt(25, 5), blue},
+	} {
+		if c := got.NRGBAAt(tt.p.X, tt.p.Y); c != tt.want {
+			t.Errorf("%v is %v, want %v", tt.p, c, tt.want)
+		}
+	}
+	if c := got.NRGBAAt(25, 17); c == blue {
+		t.Errorf("(25,17) is the background, want the image")
+	}
+}
+
+func TestFrameTransparent(t *testing.T) {
+	e := &Engine{Config: DefaultConfig}
+	o, err := parseOptions("?pad=4")
+	if err != nil {
+		t.Fatal(err)
+	}
+	img, err := e.Transform(testImage(10, 10), o)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := toNRGBA(img)
+	if size := got.Rect.Size(); size != image.Pt(18, 18) {
+		t.Fatalf("size %v, want 18x18", size)
+	}
+	if c := got.NRGBAAt(0, 0); c.A != 0 {
+		t.Errorf("padding without bg is %v, want transparent", c)
+	}
+}
@@ -0,0 +1,24 @@
+package main
+
+// Config holds the server side limits and defaults.
+type Config struct {
+	// MaxWidth and MaxHeight cap the output canvas. Zero means no cap.
+	MaxWidth, MaxHeight int
+
+	// Filter is the resampling filter used when filter is not given.
+	Filter string
+
+	// Upscale allows output larger than the source when upscale is not given.
+	Upscale bool
+
+	// StripGPS removes location tags even when exif=true keeps the rest.
+	StripGPS bool
+}
+
+var DefaultConfig = Config{
+	MaxWidth:  4096,
+	MaxHeight: 4096,
+	Filter:    "bicubic",
+	Upscale:   true,
+	StripGPS:  true,
+}
@@ -0,0 +1,84 @@
+package main
+
+import (
+	"fmt"
+	"image"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"io"
+)
+
+// DefaultQuality is the jpeg quality used when quality is not given.
+const DefaultQuality = 85
+
+// Encode writes img in format f. Formats without alpha are flattened onto
+// bg first.
+func Encode(w io.Writer, img image.Image, f *Format, o *Options) error {
+	if f.Encode == nil {
+		return fmt.Errorf("format: %s output is not supported", f.Name)
+	}
+	if !f.Alpha {
+		img = Flatten(img, o.Background)
+	}
+	return f.Encode(w, img, o)
+}
+
+// encodeJPEG maps quality 1..100 directly; progressive=true switches to
+// EncodeProgressiveJPEG.
+func encodeJPEG(w io.Writer, img image.Image, o *Options) error {
+	q := o.Quality
+	if q == 0 {
+		q = DefaultQuality
+	}
+	if o.Progressive {
+		return EncodeProgressiveJPEG(w, img, q)
+	}
+	return jpeg.Encode(w, img, &jpeg.Options{Quality: q})
+}
+
+// encodePNG ignores quality, png is lossless. colors writes an indexed
+// png of that many entries instead. image/png cannot write interlaced
+// files, so progressive is refused.
+func encodePNG(w io.Writer, img image.Image, o *Options) error {
+	if o.Progressive {
+		return fmt.Errorf("png: progressive output is not supported")
+	}
+	if o.Quantized() {
+		img = Quantize(img, o.Colors, o.Dither)
+	}
+	e := png.Encoder{CompressionLevel: png.BestCompression}
+	return e.Encode(w, img)
+}
+
+// encodeWebP writes lossless WebP, so quality is refused rather than
+// silently ignored.
+func encodeWebP(w io.Writer, img image.Image, o *Options) error {
+	if o.Progressive {
+		return fmt.Errorf("webp: progressive output is not supported")
+	}
+	if o.Quality > 0 {
+		return fmt.Errorf("webp: quality is not supported, webp output is lossless")
+	}
+	return EncodeWebP(w, img)
+}
+
+// encodeGIF maps quality to the palette size, 256 colors at 100 and
+// never fewer than 2. An explicit colors takes precedence. Either way
+// the palette comes from Quantize, so it follows the image and keeps a
+// transparent entry.
+func encodeGIF(w io.Writer, img image.Image, o *Options) error {
+	if o.Progressive {
+		return fmt.Errorf("gif: progressive output is not supported")
+	}
+	n, dither := 256, false
+	if o.Quantized() {
+		n, dither = o.Colors, o.Dither
+	} else if o.Quality > 0 {
+		n = 256 * o.Quality / 100
+		if n < 2 {
+			n = 2
+		}
+	}
+	return gif.Encode(w, Quantize(img, n, dither), nil)
+}
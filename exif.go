@@ -0,0 +1,386 @@
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"image"
+	"strings"
+)
+
+const (
+	tagOrientation = 0x0112
+	tagExifIFD     = 0x8769
+	tagGPSIFD      = 0x8825
+	tagPixelX      = 0xa002
+	tagPixelY      = 0xa003
+	tagThumbOffset = 0x0201
+	tagThumbLength = 0x0202
+)
+
+// Exif is the EXIF block of a JPEG source, kept as the raw TIFF structure
+// it is stored in.
+type Exif struct {
+	Orientation int // 1..8, 1 when absent
+
+	raw   []byte
+	order binary.ByteOrder
+}
+
+// ReadExif finds the EXIF block in JPEG data. It returns nil when there is
+// none or it is malformed.
+func ReadExif(data []byte) *Exif {
+	if len(data) < 4 || data[0] != 0xff || data[1] != 0xd8 {
+		return nil
+	}
+	for i := 2; i+4 <= len(data); {
+		if data[i] != 0xff {
+			return nil
+		}
+		marker := data[i+1]
+		if marker == 0xda || marker == 0xd9 {
+			return nil
+		}
+		n := int(data[i+2])<<8 | int(data[i+3])
+		if n < 2 || i+2+n > len(data) {
+			return nil
+		}
+		payload := data[i+4 : i+2+n]
+		if marker == 0xe1 && bytes.HasPrefix(payload, []byte("Exif\x00\x00")) {
+			return parseExif(payload[6:])
+		}
+		i += 2 + n
+	}
+	return nil
+}
+
+func parseExif(raw []byte) *Exif {
+	if len(raw) < 8 {
+		return nil
+	}
+	x := &Exif{Orientation: 1, raw: raw}
+	switch string(raw[:2]) {
+	case "II":
+		x.order = binary.LittleEndian
+	case "MM":
+		x.order = binary.BigEndian
+	default:
+		return nil
+	}
+	if x.order.Uint16(raw[2:]) != 42 {
+		return nil
+	}
+	if v, ok := x.lookup(x.ifd0(), tagOrientation); ok && v >= 1 && v <= 8 {
+		x.Orientation = v
+	}
+	return x
+}
+
+// ifd0 returns the offset of the first IFD.
+func (x *Exif) ifd0() int {
+	return int(x.order.Uint32(x.raw[4:]))
+}
+
+// entries returns the 12 byte entries of the IFD at off, or nil.
+func (x *Exif) entries(off int) []byte {
+	if off <= 0 || off+2 > len(x.raw) {
+		return nil
+	}
+	n := int(x.order.Uint16(x.raw[off:]))
+	end := off + 2 + n*12
+	if end+4 > len(x.raw) {
+		return nil
+	}
+	return x.raw[off+2 : end]
+}
+
+// lookup returns the SHORT or LONG value of tag in the IFD at off.
+func (x *Exif) lookup(off int, tag uint16) (int, bool) {
+	e := x.entries(off)
+	for i := 0; i+12 <= len(e); i += 12 {
+		if x.order.Uint16(e[i:]) != tag {
+			continue
+		}
+		switch x.order.Uint16(e[i+2:]) {
+		case 3:
+			return int(x.order.Uint16(e[i+8:])), true
+		case 4:
+			return int(x.order.Uint32(e[i+8:])), true
+		}
+	}
+	return 0, false
+}
+
+// set overwrites the SHORT or LONG value of tag in the IFD at off, if
+// present.
+func (x *Exif) set(raw []byte, off int, tag uint16, v int) {
+	e := x.entries(off)
+	base := off + 2
+	for i := 0; i+12 <= len(e); i += 12 {
+		if x.order.Uint16(e[i:]) != tag {
+			continue
+		}
+		switch x.order.Uint16(e[i+2:]) {
+		case 3:
+			x.order.PutUint16(raw[base+i+8:], uint16(v))
+		case 4:
+			x.order.PutUint32(raw[base+i+8:], uint32(v))
+		}
+	}
+}
+
+// typeSizes are the byte sizes of the TIFF field types.
+var typeSizes = [...]int{0, 1, 1, 2, 4, 8, 1, 1, 2, 4, 8, 4, 8}
+
+// exifTags names the IFD0 and Exif IFD tags reported in metadata.
+var exifTags = map[uint16]string{
+	0x010f: "Make",
+	0x0110: "Model",
+	0x0112: "Orientation",
+	0x011a: "XResolution",
+	0x011b: "YResolution",
+	0x0128: "ResolutionUnit",
+	0x0131: "Software",
+	0x0132: "DateTime",
+	0x013b: "Artist",
+	0x8298: "Copyright",
+	0x829a: "ExposureTime",
+	0x829d: "FNumber",
+	0x8822: "ExposureProgram",
+	0x8827: "ISOSpeedRatings",
+	0x9003: "DateTimeOriginal",
+	0x9004: "DateTimeDigitized",
+	0x9201: "ShutterSpeedValue",
+	0x9202: "ApertureValue",
+	0x9204: "ExposureBiasValue",
+	0x9207: "MeteringMode",
+	0x9209: "Flash",
+	0x920a: "FocalLength",
+	0xa001: "ColorSpace",
+	0xa002: "PixelXDimension",
+	0xa003: "PixelYDimension",
+	0xa405: "FocalLengthIn35mmFilm",
+	0xa433: "LensMake",
+	0xa434: "LensModel",
+}
+
+// gpsTags names the GPS IFD tags reported in metadata.
+var gpsTags = map[uint16]string{
+	0x0001: "GPSLatitudeRef",
+	0x0002: "GPSLatitude",
+	0x0003: "GPSLongitudeRef",
+	0x0004: "GPSLongitude",
+	0x0005: "GPSAltitudeRef",
+	0x0006: "GPSAltitude",
+	0x0007: "GPSTimeStamp",
+	0x001d: "GPSDateStamp",
+}
+
+// Fields returns the known tags by name. Strings are trimmed, numbers
+// are ints or floats, and lists for multi-valued tags. The GPS tags are
+// left out with stripGPS.
+func (x *Exif) Fields(stripGPS bool) map[string]interface{} {
+	fields := map[string]interface{}{}
+	ifd0 := x.ifd0()
+	x.fields(fields, ifd0, exifTags)
+	if sub, ok := x.lookup(ifd0, tagExifIFD); ok {
+		x.fields(fields, sub, exifTags)
+	}
+	if gps, ok := x.lookup(ifd0, tagGPSIFD); ok && !stripGPS {
+		x.fields(fields, gps, gpsTags)
+	}
+	return fields
+}
+
+func (x *Exif) fields(fields map[string]interface{}, off int, names map[uint16]string) {
+	e := x.entries(off)
+	for i := 0; i+12 <= len(e); i += 12 {
+		name, ok := names[x.order.Uint16(e[i:])]
+		if !ok {
+			continue
+		}
+		if v := x.value(e[i : i+12]); v != nil {
+			fields[name] = v
+		}
+	}
+}
+
+// value decodes an ASCII, SHORT, LONG, SLONG, RATIONAL or SRATIONAL entry.
+func (x *Exif) value(entry []byte) interface{} {
+	typ := int(x.order.Uint16(entry[2:]))
+	count := int(x.order.Uint32(entry[4:]))
+	if typ >= len(typeSizes) || count <= 0 || count > 1024 {
+		return nil
+	}
+	size := typeSizes[typ] * count
+	data := entry[8:12]
+	if size > 4 {
+		off := int(x.order.Uint32(entry[8:]))
+		if off+size > len(x.raw) {
+			return nil
+		}
+		data = x.raw[off : off+size]
+	}
+	if typ == 2 {
+		return strings.TrimRight(string(data[:size]), "\x00 ")
+	}
+	var list []interface{}
+	for k := 0; k < count; k++ {
+		switch typ {
+		case 3:
+			list = append(list, int(x.order.Uint16(data[k*2:])))
+		case 4:
+			list = append(list, int(x.order.Uint32(data[k*4:])))
+		case 9:
+			list = append(list, int(int32(x.order.Uint32(data[k*4:]))))
+		case 5, 10:
+			n, d := x.order.Uint32(data[k*8:]), x.order.Uint32(data[k*8+4:])
+			if d == 0 {
+				return nil
+			}
+			if typ == 10 {
+				list = append(list, float64(int32(n))/float64(int32(d)))
+			} else {
+				list = append(list, float64(n)/float64(d))
+			}
+		default:
+			return nil
+		}
+	}
+	if len(list) == 1 {
+		return list[0]
+	}
+	return list
+}
+
+// Encode returns a copy of the block fit for an image of size that is
+// already upright: Orientation is 1, the pixel dimensions are size and the
+// thumbnail, which no longer matches and may show what was redacted, is
+// zeroed along with IFD1. With stripGPS the GPS IFD and its values are
+// zeroed too.
+func (x *Exif) Encode(size image.Point, stripGPS bool) []byte {
+	raw := append([]byte(nil), x.raw...)
+	ifd0 := x.ifd0()
+	x.set(raw, ifd0, tagOrientation, 1)
+	if sub, ok := x.lookup(ifd0, tagExifIFD); ok {
+		x.set(raw, sub, tagPixelX, size.X)
+		x.set(raw, sub, tagPixelY, size.Y)
+	}
+	if e := x.entries(ifd0); e != nil {
+		next := ifd0 + 2 + len(e)
+		if ifd1 := int(x.order.Uint32(raw[next:])); ifd1 > 0 && ifd1 != ifd0 {
+			off, ok := x.lookup(ifd1, tagThumbOffset)
+			n, ok2 := x.lookup(ifd1, tagThumbLength)
+			if ok && ok2 && off > 0 && off+n <= len(raw) {
+				zero(raw[off : off+n])
+			}
+			x.clear(raw, ifd1)
+		}
+		x.order.PutUint32(raw[next:], 0)
+	}
+	if gps, ok := x.lookup(ifd0, tagGPSIFD); ok && stripGPS {
+		x.clear(raw, gps)
+	}
+	return raw
+}
+
+// clear zeroes the IFD at off in raw together with the values its
+// entries keep outside of it.
+func (x *Exif) clear(raw []byte, off int) {
+	e := x.entries(off)
+	if e == nil {
+		return
+	}
+	for i := 0; i+12 <= len(e); i += 12 {
+		typ := int(x.order.Uint16(e[i+2:]))
+		if typ >= len(typeSizes) {
+			continue
+		}
+		n := typeSizes[typ] * int(x.order.Uint32(e[i+4:]))
+		if v := int(x.order.Uint32(e[i+8:])); n > 4 && v+n <= len(raw) {
+			zero(raw[v : v+n])
+		}
+	}
+	zero(raw[off : off+2+len(e)+4])
+}
+
+func zero(b []byte) {
+	for i := range b {
+		b[i] = 0
+	}
+}
+
+// embedExif inserts the TIFF block raw into encoded data of format f:
+// an APP1 segment for jpg, an eXIf chunk for png. Other formats cannot
+// carry EXIF and are returned unchanged.
+func embedExif(data []byte, f *Format, raw []byte) ([]byte, error) {
+	var out bytes.Buffer
+	switch f.Name {
+	case "jpg":
+		n := len(raw) + 8
+		if n > 0xffff {
+			return nil, errors.New("exif: metadata is too large for jpeg")
+		}
+		out.Write(data[:2])
+		out.Write([]byte{0xff, 0xe1, byte(n >> 8), byte(n)})
+		out.WriteString("Exif\x00\x00")
+		out.Write(raw)
+		out.Write(data[2:])
+	case "png":
+		// signature and IHDR
+		const head = 8 + 25
+		out.Write(data[:head])
+		var n [4]byte
+		binary.BigEndian.PutUint32(n[:], uint32(len(raw)))
+		out.Write(n[:])
+		crc := crc32.NewIEEE()
+		crc.Write([]byte("eXIf"))
+		crc.Write(raw)
+		out.WriteString("eXIf")
+		out.Write(raw)
+		binary.BigEndian.PutUint32(n[:], crc.Sum32())
+		out.Write(n[:])
+		out.Write(data[head:])
+	default:
+		return data, nil
+	}
+	return out.Bytes(), nil
+}
+
+// Orient turns img upright according to an EXIF orientation, 1..8.
+func Orient(img image.Image, orientation int) image.Image {
+	if orientation < 2 || orientation > 8 {
+		return img
+	}
+	src := toNRGBA(img)
+	w, h := src.Rect.Dx(), src.Rect.Dy()
+	size := image.Pt(w, h)
+	if orientation >= 5 {
+		size = image.Pt(h, w)
+	}
+	dst := image.NewNRGBA(image.Rectangle{Max: size})
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			var dx, dy int
+			switch orientation {
+			case 2:
+				dx, dy = w-1-x, y
+			case 3:
+				dx, dy = w-1-x, h-1-y
+			case 4:
+				dx, dy = x, h-1-y
+			case 5:
+				dx, dy = y, x
+			case 6:
+				dx, dy = h-1-y, x
+			case 7:
+				dx, dy = h-1-y, w-1-x
+			case 8:
+				dx, dy = y, w-1-x
+			}
+			copy(dst.Pix[dst.PixOffset(dx, dy):][:4], src.Pix[src.PixOffset(x, y):][:4])
+		}
+	}
+	return dst
+}
@@ -0,0 +1,250 @@
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// tiffBlock builds a little endian EXIF block with orientation and, with
+// gps, a GPS IFD holding a latitude.
+func tiffBlock(orientation int, gps bool) []byte {
+	le := binary.LittleEndian
+	var b bytes.Buffer
+	b.WriteString("II")
+	binary.Write(&b, le, uint16(42))
+	binary.Write(&b, le, uint32(8))
+
+	entry := func(tag, typ uint16, count, value uint32) {
+		binary.Write(&b, le, tag)
+		binary.Write(&b, le, typ)
+		binary.Write(&b, le, count)
+		binary.Write(&b, le, value)
+	}
+	n := uint16(1)
+	if gps {
+		n = 2
+	}
+	binary.Write(&b, le, n)
+	entry(tagOrientation, 3, 1, uint32(orientation))
+	gpsIFD := uint32(8 + 2 + 12*int(n) + 4)
+	if gps {
+		entry(tagGPSIFD, 4, 1, gpsIFD)
+	}
+	binary.Write(&b, le, uint32(0))
+	if gps {
+		latitude := gpsIFD + 2 + 2*12 + 4
+		binary.Write(&b, le, uint16(2))
+		entry(0x0001, 2, 2, 'N')
+		entry(0x0002, 5, 3, latitude)
+		binary.Write(&b, le, uint32(0))
+		for _, v := range []uint32{52, 1, 31, 1, 12, 1} {
+			binary.Write(&b, le, v)
+		}
+	}
+	return b.Bytes()
+}
+
+// jpegWithExif encodes img as a jpeg carrying tiffBlock(orientation, gps).
+func jpegWithExif(t *testing.T, img image.Image, orientation int, gps bool) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: 95}); err != nil {
+		t.Fatal(err)
+	}
+	data, err := embedExif(buf.Bytes(), formats["jpg"], tiffBlock(orientation, gps))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+// TestOrient stores an upright 3x2 image the way a camera would for each
+// orientation and checks that Orient undoes it.
+func TestOrient(t *testing.T) {
+	const w, h = 3, 2
+	upright := image.NewNRGBA(image.Rect(0, 0, w, h))
+	for i := 0; i < w*h; i++ {
+		upright.SetNRGBA(i%w, i/w, color.NRGBA{uint8(i * 40), uint8(i), 0, 0xff})
+	}
+	// stored maps a pixel of the stored image to the upright one. For 6,
+	// say, row 0 of the stored image is the right side of the upright one.
+	stored := map[int]func(x, y int) (int, int){
+		1: func(x, y int) (int, int) { return x, y },
+		2: func(x, y int) (int, int) { return w - 1 - x, y },
+		3: func(x, y int) (int, int) { return w - 1 - x, h - 1 - y },
+		4: func(x, y int) (int, int) { return x, h - 1 - y },
+		5: func(x, y int) (int, int) { return y, x },
+		6: func(x, y int) (int, int) { return w - 1 - y, x },
+		7: func(x, y int) (int, int) { return w - 1 - y, h - 1 - x },
+		8: func(x, y int) (int, int) { return y, h - 1 - x },
+	}
+	for orientation := 1; orientation <= 8; orientation++ {
+		size := image.Pt(w, h)
+		if orientation >= 5 {
+			size = image.Pt(h, w)
+		}
+		src := image.NewNRGBA(image.Rectangle{Max: size})
+		for y := 0; y < size.Y; y++ {
+			for x := 0; x < size.X; x++ {
+				ux, uy := stored[orientation](x, y)
+				src.SetNRGBA(x, y, upright.NRGBAAt(ux, uy))
+			}
+		}
+		got := toNRGBA(Orient(src, orientation))
+		if !bytes.Equal(got.Pix, upright.Pix) || got.Rect != upright.Rect {
+			t.Errorf("orientation %d: not upright", orientation)
+		}
+	}
+}
+
+func TestLoadOrients(t *testing.T) {
+	dir := t.TempDir()
+	data := jpegWithExif(t, Flatten(testImage(20, 10), nil), 6, false)
+	if err := os.WriteFile(filepath.Join(dir, "a.jpg"), data, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	src, err := Load(Dir(dir), "a.jpg")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if size := src.Image.Bounds().Size(); size != image.Pt(10, 20) {
+		t.Errorf("size %v, want (10,20)", size)
+	}
+	if src.Exif == nil || src.Exif.Orientation != 6 {
+		t.Errorf("exif %+v, want orientation 6", src.Exif)
+	}
+}
+
+func TestExifGPS(t *testing.T) {
+	dir := t.TempDir()
+	data := jpegWithExif(t, Flatten(testImage(20, 10), nil), 6, true)
+	if err := os.WriteFile(filepath.Join(dir, "a.jpg"), data, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		stripConfig bool
+		query       string
+		exif        bool // the output has EXIF at all
+		gps         bool
+	}{
+		{true, "exif=true", true, false},
+		{false, "exif=true", true, true},
+		{false, "exif=true&strip-gps=true", true, false},
+		{true, "exif=true&strip-gps=false", true, false},
+		{false, "w=5", false, false},
+	}
+	for _, tt := range tests {
+		e := &Engine{Config: DefaultConfig, Origin: Dir(dir)}
+		e.Config.StripGPS = tt.stripConfig
+		h := &Handler{Engine: e}
+
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest("GET", "/a.jpg?"+tt.query, nil))
+		if rec.Code != 200 {
+			t.Fatalf("%s: status %d: %s", tt.query, rec.Code, rec.Body)
+		}
+		x := ReadExif(rec.Body.Bytes())
+		if (x != nil) != tt.exif {
+			t.Fatalf("config %v, %s: exif %v, want %v", tt.stripConfig, tt.query, x != nil, tt.exif)
+		}
+		if x == nil {
+			continue
+		}
+		if x.Orientation != 1 {
+			t.Errorf("%s: orientation %d, want 1 after turning upright", tt.query, x.Orientation)
+		}
+		if _, ok := x.Fields(false)["GPSLatitude"]; ok != tt.gps {
+			t.Errorf("config %v, %s: gps %v, want %v", tt.stripConfig, tt.query, ok, tt.gps)
+		}
+
+		// format=json follows the same policy.
+		rec = httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest("GET", "/a.jpg?format=json&"+tt.query, nil))
+		var m struct{ Exif map[string]interface{} }
+		if err := json.Unmarshal(rec.Body.Bytes(), &m); err != nil {
+			t.Fatalf("%s: %v", tt.query, err)
+		}
+		if _, ok := m.Exif["GPSLatitude"]; ok != tt.gps {
+			t.Errorf("config %v, format=json&%s: gps %v, want %v", tt.stripConfig, tt.query, ok, tt.gps)
+		}
+	}
+}
+
+// TestExifThumbnail checks a camera thumbnail, which shows the whole
+// unedited picture, is not carried into the output.
+func TestExifThumbnail(t *testing.T) {
+	src := Flatten(testImage(40, 30), nil)
+	var thumb bytes.Buffer
+	if err := jpeg.Encode(&thumb, src, &jpeg.Options{Quality: 50}); err != nil {
+		t.Fatal(err)
+	}
+
+	// IFD0 with an orientation at 8, IFD1 at 26 and the thumbnail at 68.
+	le := binary.LittleEndian
+	var b bytes.Buffer
+	entry := func(tag, typ uint16, count, value uint32) {
+		binary.Write(&b, le, tag)
+		binary.Write(&b, le, typ)
+		binary.Write(&b, le, count)
+		binary.Write(&b, le, value)
+	}
+	b.WriteString("II")
+	binary.Write(&b, le, uint16(42))
+	binary.Write(&b, le, uint32(8))
+	binary.Write(&b, le, uint16(1))
+	entry(tagOrientation, 3, 1, 1)
+	binary.Write(&b, le, uint32(26))
+	binary.Write(&b, le, uint16(3))
+	entry(0x0103, 3, 1, 6)
+	entry(tagThumbOffset, 4, 1, 68)
+	entry(tagThumbLength, 4, 1, uint32(thumb.Len()))
+	binary.Write(&b, le, uint32(0))
+	b.Write(thumb.Bytes())
+
+	var main bytes.Buffer
+	if err := jpeg.Encode(&main, src, &jpeg.Options{Quality: 95}); err != nil {
+		t.Fatal(err)
+	}
+	data, err := embedExif(main.Bytes(), formats["jpg"], b.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "a.jpg"), data, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	h := &Handler{Engine: &Engine{Config: DefaultConfig, Origin: Dir(dir)}}
+	rec := get(t, h, "/a.jpg?redact(x0,y0,w20,h15)&exif=true")
+	if rec.Code != 200 {
+		t.Fatalf("status %d: %s", rec.Code, rec.Body)
+	}
+	x := ReadExif(rec.Body.Bytes())
+	if x == nil {
+		t.Fatal("the output has no EXIF")
+	}
+	if len(x.raw) != b.Len() {
+		t.Fatalf("EXIF is %d bytes, want %d", len(x.raw), b.Len())
+	}
+	if next := le.Uint32(x.raw[22:]); next != 0 {
+		t.Errorf("IFD0 still links IFD1 at %d", next)
+	}
+	for i, c := range x.raw[26:] {
+		if c != 0 {
+			t.Fatalf("byte %d of IFD1 and the thumbnail is %#x, want 0", 26+i, c)
+		}
+	}
+	// The end of the scan, as the headers match those of any jpeg.
+	tail := thumb.Bytes()[thumb.Len()-18 : thumb.Len()-2]
+	if bytes.Contains(rec.Body.Bytes(), tail) {
+		t.Error("the output contains thumbnail data")
+	}
+}
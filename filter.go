@@ -0,0 +1,167 @@
+package main
+
+import (
+	"image"
+	"image/draw"
+	"math"
+)
+
+// Filter applies blur, sharp and px, in that order. All three work on
+// premultiplied pixels so transparent areas do not bleed color.
+func Filter(img image.Image, o *Options) image.Image {
+	if o.Blur == 0 && o.Sharpen == 0 && o.Pixelate < 2 {
+		return img
+	}
+	dst := toRGBA(img)
+	if o.Blur > 0 {
+		blurRGBA(dst, float64(o.Blur)/20)
+	}
+	if o.Sharpen > 0 {
+		sharpenRGBA(dst, float64(o.Sharpen)/50)
+	}
+	if o.Pixelate > 1 {
+		pixelateRGBA(dst, o.Pixelate)
+	}
+	return dst
+}
+
+// blurRGBA approximates a gaussian blur of the given sigma with three box
+// blurs, which keeps the cost independent of the radius.
+func blurRGBA(img *image.RGBA, sigma float64) {
+	if sigma <= 0 {
+		return
+	}
+	for _, r := range boxRadii(sigma, 3) {
+		boxBlur(img, r, true)
+		boxBlur(img, r, false)
+	}
+}
+
+// boxRadii returns n box radii whose passes add up to a gaussian of sigma.
+func boxRadii(sigma float64, n int) []int {
+	wIdeal := math.Sqrt(12*sigma*sigma/float64(n) + 1)
+	wl := int(wIdeal)
+	if wl%2 == 0 {
+		wl--
+	}
+	wu := wl + 2
+	mIdeal := (12*sigma*sigma - float64(n*wl*wl) - float64(4*n*wl) - float64(3*n)) / float64(-4*wl-4)
+	m := int(math.Floor(mIdeal + 0.5))
+
+	radii := make([]int, n)
+	for i := range radii {
+		if i < m {
+			radii[i] = (wl - 1) / 2
+		} else {
+			radii[i] = (wu - 1) / 2
+		}
+	}
+	return radii
+}
+
+// boxBlur runs one horizontal or vertical box pass of radius r in place.
+// Pixels outside img.Rect repeat the nearest edge.
+func boxBlur(img *image.RGBA, r int, horizontal bool) {
+	if r < 1 {
+		return
+	}
+	b := img.Rect
+	lines, length, step := b.Dy(), b.Dx(), 4
+	if !horizontal {
+		lines, length, step = b.Dx(), b.Dy(), img.Stride
+	}
+	line := make([]uint8, length*4)
+	div := uint32(2*r + 1)
+
+	for l := 0; l < lines; l++ {
+		start := img.PixOffset(b.Min.X, b.Min.Y+l)
+		if !horizontal {
+			start = img.PixOffset(b.Min.X+l, b.Min.Y)
+		}
+		for i := 0; i < length; i++ {
+			copy(line[i*4:i*4+4], img.Pix[start+i*step:start+i*step+4])
+		}
+		at := func(i int) []uint8 {
+			if i < 0 {
+				i = 0
+			} else if i >= length {
+				i = length - 1
+			}
+			return line[i*4 : i*4+4]
+		}
+
+		var sum [4]uint32
+		for i := -r; i <= r; i++ {
+			px := at(i)
+			for c := 0; c < 4; c++ {
+				sum[c] += uint32(px[c])
+			}
+		}
+		for i := 0; i < length; i++ {
+			o := start + i*step
+			for c := 0; c < 4; c++ {
+				img.Pix[o+c] = uint8((sum[c] + div/2) / div)
+			}
+			out, in := at(i-r), at(i+r+1)
+			for c := 0; c < 4; c++ {
+				sum[c] += uint32(in[c]) - uint32(out[c])
+			}
+		}
+	}
+}
+
+// sharpenRGBA is an unsharp mask: img + amount*(img - blur(img)).
+func sharpenRGBA(img *image.RGBA, amount float64) {
+	blurred := image.NewRGBA(img.Rect)
+	draw.Draw(blurred, img.Rect, img, img.Rect.Min, draw.Src)
+	blurRGBA(blurred, 1)
+
+	b := img.Rect
+	for y := b.Min.Y; y < b.Max.Y; y++ {
+		i := img.PixOffset(b.Min.X, y)
+		j := blurred.PixOffset(b.Min.X, y)
+		for x := 0; x < b.Dx(); x, i, j = x+1, i+4, j+4 {
+			a := float64(img.Pix[i+3])
+			for c := 0; c < 3; c++ {
+				v := float64(img.Pix[i+c])
+				img.Pix[i+c] = uint8(clamp(v+amount*(v-float64(blurred.Pix[j+c]))+0.5, 0, a))
+			}
+		}
+	}
+}
+
+// pixelateRGBA replaces every size x size block by its average.
+func pixelateRGBA(img *image.RGBA, size int) {
+	b := img.Rect
+	for by := b.Min.Y; by < b.Max.Y; by += size {
+		for bx := b.Min.X; bx < b.Max.X; bx += size {
+			block := image.Rect(bx, by, bx+size, by+size).Intersect(b)
+			var sum [4]uint32
+			for y := block.Min.Y; y < block.Max.Y; y++ {
+				i := img.PixOffset(block.Min.X, y)
+				for x := block.Min.X; x < block.Max.X; x, i = x+1, i+4 {
+					for c := 0; c < 4; c++ {
+						sum[c] += uint32(img.Pix[i+c])
+					}
+				}
+			}
+			n := uint32(block.Dx() * block.Dy())
+			for y := block.Min.Y; y < block.Max.Y; y++ {
+				i := img.PixOffset(block.Min.X, y)
+				for x := block.Min.X; x < block.Max.X; x, i = x+1, i+4 {
+					for c := 0; c < 4; c++ {
+						img.Pix[i+c] = uint8((sum[c] + n/2) / n)
+					}
+				}
+			}
+		}
+	}
+}
+
+// toRGBA returns a premultiplied copy of img with its origin at 0,0.
+func toRGBA(img image.Image) *image.RGBA {
+	b := img.Bounds()
+	dst := image.NewRGBA(image.Rect(0, 0, b.Dx(), b.Dy()))
+	draw.Draw(dst, dst.Bounds(), img, b.Min, draw.Src)
+	return dst
+}
@@ -0,0 +1,142 @@
+package main
+
+import (
+	"image"
+	"image/color"
+	"testing"
+)
+
+// checker is a w x h checkerboard of size x size squares in a and b.
+func checker(w, h, size int, a, b color.NRGBA) *image.NRGBA {
+	img := image.NewNRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			c := a
+			if (x/size+y/size)%2 == 1 {
+				c = b
+			}
+			img.SetNRGBA(x, y, c)
+		}
+	}
+	return img
+}
+
+// stats returns the mean and variance of the red channel of img.
+func stats(img image.Image) (mean, variance float64) {
+	n := toNRGBA(img)
+	count := float64(len(n.Pix) / 4)
+	for i := 0; i < len(n.Pix); i += 4 {
+		mean += float64(n.Pix[i])
+	}
+	mean /= count
+	for i := 0; i < len(n.Pix); i += 4 {
+		d := float64(n.Pix[i]) - mean
+		variance += d * d
+	}
+	return mean, variance / count
+}
+
+func TestFilterBlur(t *testing.T) {
+	src := checker(64, 64, 8, color.NRGBA{0, 0, 0, 255}, color.NRGBA{255, 255, 255, 255})
+	mean, variance := stats(src)
+	for _, blur := range []int{40, 100, 400} {
+		out := Filter(src, &Options{Blur: blur})
+		if out.Bounds() != src.Bounds() {
+			t.Fatalf("blur=%d: bounds %v, want %v", blur, out.Bounds(), src.Bounds())
+		}
+		m, v := stats(out)
+		if d := m - mean; d < -1 || d > 1 {
+			t.Errorf("blur=%d: mean %.2f, want %.2f", blur, m, mean)
+		}
+		if v >= variance/2 {
+			t.Errorf("blur=%d: variance %.0f, want well below %.0f", blur, v, variance)
+		}
+		variance = v
+	}
+
+	flat := uniform(16, 16, color.NRGBA{90, 120, 150, 255})
+	if e := meanError(Filter(flat, &Options{Blur: 100}), flat); e != 0 {
+		t.Errorf("blur changed a uniform image by %.2f", e)
+	}
+}
+
+func TestFilterBlurNoBleed(t *testing.T) {
+	// Red on the left, fully transparent green on the right: the blurred
+	// edge may fade but must stay red.
+	src := image.NewNRGBA(image.Rect(0, 0, 32, 8))
+	for y := 0; y < 8; y++ {
+		for x := 0; x < 32; x++ {
+			c := color.NRGBA{255, 0, 0, 255}
+			if x >= 16 {
+				c = color.NRGBA{0, 255, 0, 0}
+			}
+			src.SetNRGBA(x, y, c)
+		}
+	}
+	out := toNRGBA(Filter(src, &Options{Blur: 40}))
+	for x := 12; x < 20; x++ {
+		c := out.NRGBAAt(x, 4)
+		if c.A > 16 && (c.G > 8 || c.R < 240) {
+			t.Errorf("x=%d: %v, want faded red", x, c)
+		}
+	}
+}
+
+func TestFilterSharpen(t *testing.T) {
+	// A vertical step from gray to light gray gains contrast on both
+	// sides of the edge and stays flat away from it.
+	src := image.NewNRGBA(image.Rect(0, 0, 32, 8))
+	for y := 0; y < 8; y++ {
+		for x := 0; x < 32; x++ {
+			c := color.NRGBA{80, 80, 80, 255}
+			if x >= 16 {
+				c = color.NRGBA{160, 160, 160, 255}
+			}
+			src.SetNRGBA(x, y, c)
+		}
+	}
+	out := toNRGBA(Filter(src, &Options{Sharpen: 100}))
+	if c := out.NRGBAAt(15, 4); c.R >= 80 {
+		t.Errorf("dark side of the edge is %d, want below 80", c.R)
+	}
+	if c := out.NRGBAAt(16, 4); c.R <= 160 {
+		t.Errorf("light side of the edge is %d, want above 160", c.R)
+	}
+	if c := out.NRGBAAt(2, 4); c.R != 80 {
+		t.Errorf("far from the edge: %d, want 80", c.R)
+	}
+}
+
+func TestFilterPixelate(t *testing.T) {
+	src := Flatten(testImage(50, 30), nil)
+	const size = 8
+	out := toNRGBA(Filter(src, &Options{Pixelate: size}))
+	in := toNRGBA(src)
+	b := out.Bounds()
+	for by := 0; by < b.Dy(); by += size {
+		for bx := 0; bx < b.Dx(); bx += size {
+			block := image.Rect(bx, by, bx+size, by+size).Intersect(b)
+			first := out.NRGBAAt(block.Min.X, block.Min.Y)
+			var sum, n int
+			for y := block.Min.Y; y < block.Max.Y; y++ {
+				for x := block.Min.X; x < block.Max.X; x++ {
+					if c := out.NRGBAAt(x, y); c != first {
+						t.Fatalf("block %v: %v at %d,%d differs from %v", block, c, x, y, first)
+					}
+					sum += int(in.NRGBAAt(x, y).G)
+					n++
+				}
+			}
+			if d := int(first.G) - (sum+n/2)/n; d < -2 || d > 2 {
+				t.Errorf("block %v: green %d, want the block average %d", block, first.G, sum/n)
+			}
+		}
+	}
+}
+
+func TestFilterNoop(t *testing.T) {
+	var img image.Image = uniform(4, 4, color.NRGBA{1, 2, 3, 255})
+	if out := Filter(img, &Options{Pixelate: 1}); out != img {
+		t.Error("Filter without filters copied the image")
+	}
+}
@@ -0,0 +1,154 @@
+package main
+
+import (
+	"fmt"
+	"image"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// Format describes an image format the service knows.
+type Format struct {
+	Name      string
+	MediaType string
+	Alpha     bool // can store transparency
+	Decode    bool // sources in this format can be read
+	Meta      bool // written by Render from metadata, not encoded
+	Quality   bool // the encoder honors quality
+	Lossless  bool // the encoder keeps every pixel
+	Paletted  bool // the encoder writes colors as an indexed palette
+
+	// Encode writes an image in this format. It is nil for formats
+	// that can only be read.
+	Encode func(w io.Writer, img image.Image, o *Options) error
+}
+
+var formats = map[string]*Format{
+	"png":  {Name: "png", MediaType: "image/png", Alpha: true, Decode: true, Lossless: true, Paletted: true, Encode: encodePNG},
+	"jpg":  {Name: "jpg", MediaType: "image/jpeg", Decode: true, Quality: true, Encode: encodeJPEG},
+	"jpeg": {Name: "jpg", MediaType: "image/jpeg", Decode: true, Quality: true, Encode: encodeJPEG},
+	"gif":  {Name: "gif", MediaType: "image/gif", Alpha: true, Decode: true, Quality: true, Paletted: true, Encode: encodeGIF},
+	"webp": {Name: "webp", MediaType: "image/webp", Alpha: true, Decode: true, Lossless: true, Encode: encodeWebP},
+	"bmp":  {Name: "bmp", MediaType: "image/bmp", Decode: true},
+	"tiff": {Name: "tiff", MediaType: "image/tiff", Alpha: true, Decode: true},
+	"tif":  {Name: "tiff", MediaType: "image/tiff", Alpha: true, Decode: true},
+	"json": {Name: "json", MediaType: "application/json", Meta: true},
+}
+
+// Writable reports whether f can be the output format.
+func (f *Format) Writable() bool {
+	return f.Encode != nil || f.Meta
+}
+
+// LookupFormat returns the format registered as name.
+func LookupFormat(name string) (*Format, bool) {
+	f, ok := formats[name]
+	return f, ok
+}
+
+// OutputFormat picks the format to write. An explicit format always
+// wins, and is an error when it cannot honor lossless or colors.
+// Otherwise the source format is kept, unless it is decode-only, the
+// options need alpha and it has none, or it cannot honor lossless or
+// colors, in which case png is used.
+func OutputFormat(o *Options, source string) (*Format, error) {
+	name := o.Format
+	if name == "" {
+		name = source
+	}
+	if name == "auto" {
+		return nil, fmt.Errorf("format: auto must be resolved with AutoFormat")
+	}
+	f, ok := LookupFormat(name)
+	if !ok {
+		return nil, fmt.Errorf("format: unsupported format %q", name)
+	}
+	if !f.Writable() {
+		if o.Format != "" {
+			return nil, fmt.Errorf("format: %s can be read but not written", name)
+		}
+		f = formats["png"]
+	}
+	if f.Meta {
+		return f, nil
+	}
+	lossy := o.Lossless && !f.Lossless
+	unpaletted := o.Quantized() && !f.Paletted
+	if o.Format != "" {
+		if lossy {
+			return nil, fmt.Errorf("lossless: %s output is lossy", f.Name)
+		}
+		if unpaletted {
+			return nil, fmt.Errorf("colors: %s output has no palette", f.Name)
+		}
+	} else if lossy || unpaletted || o.NeedsAlpha() && !f.Alpha {
+		f = formats["png"]
+	}
+	return f, nil
+}
+
+// ChooseFormat is the format=auto policy: gif for animations, since
+// webp output is still only; jpg when alpha need not survive, as webp
+// output is lossless and far larger for photos; otherwise webp when
+// accept allows it and png when not.
+func ChooseFormat(accept string, alpha, animated bool) *Format {
+	switch {
+	case animated:
+		return formats["gif"]
+	case !alpha:
+		return formats["jpg"]
+	case acceptsMediaType(accept, "image/webp"):
+		return formats["webp"]
+	}
+	return formats["png"]
+}
+
+// AutoFormat applies ChooseFormat to src under o. A request that picks
+// one frame is not animated; one that masks needs alpha. Lossless output
+// is webp or png, and colors output png. A quality the chosen format
+// does not honor is dropped with a diagnostic, as the caller did not ask
+// for that format.
+func AutoFormat(accept string, src *Source, o *Options) *Format {
+	animated := src.Anim != nil && o.Frame == 0
+	f := formats["png"]
+	if !o.Quantized() || animated {
+		alpha := o.NeedsAlpha() || o.Lossless || !isOpaque(src.Image)
+		f = ChooseFormat(accept, alpha, animated)
+	}
+	if o.Quality > 0 && !f.Quality {
+		o.Diagnostics = append(o.Diagnostics, "quality ignored: format=auto chose lossless "+f.Name)
+		o.Quality = 0
+	}
+	return f
+}
+
+// acceptsMediaType reports whether an Accept header names mediaType with
+// a q above zero. Wildcards do not count: browsers send */* without
+// supporting every image format.
+func acceptsMediaType(accept, mediaType string) bool {
+	for _, part := range strings.Split(accept, ",") {
+		fields := strings.Split(part, ";")
+		if !strings.EqualFold(strings.TrimSpace(fields[0]), mediaType) {
+			continue
+		}
+		q := 1.0
+		for _, param := range fields[1:] {
+			param = strings.TrimSpace(param)
+			if strings.HasPrefix(param, "q=") {
+				q, _ = strconv.ParseFloat(param[2:], 64)
+			}
+		}
+		return q > 0
+	}
+	return false
+}
+
+// isOpaque reports whether img has no transparent pixels, trusting the
+// image type where it can tell.
+func isOpaque(img image.Image) bool {
+	if o, ok := img.(interface{ Opaque() bool }); ok {
+		return o.Opaque()
+	}
+	return toNRGBA(img).Opaque()
+}
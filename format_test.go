@@ -0,0 +1,127 @@
+package main
+
+import (
+	"image"
+	"image/gif"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestAcceptsMediaType(t *testing.T) {
+	tests := []struct {
+		accept string
+		want   bool
+	}{
+		{"", false},
+		{"image/webp", true},
+		{"image/avif,image/webp,image/apng,image/*,*/*;q=0.8", true},
+		{"IMAGE/WEBP", true},
+		{"image/png, image/webp ;q=0.5", true},
+		{"image/webp;q=0", false},
+		{"image/webp;q=0.0, image/png", false},
+		{"*/*", false},
+		{"image/*", false},
+		{"image/png,image/*;q=0.8,*/*;q=0.5", false},
+		{"image/webpx", false},
+	}
+	for _, tt := range tests {
+		if got := acceptsMediaType(tt.accept, "image/webp"); got != tt.want {
+			t.Errorf("acceptsMediaType(%q) = %v, want %v", tt.accept, got, tt.want)
+		}
+	}
+}
+
+func TestChooseFormat(t *testing.T) {
+	const webp = "image/webp,*/*"
+	tests := []struct {
+		accept          string
+		alpha, animated bool
+		want            string
+	}{
+		{"", false, false, "jpg"},
+		{"*/*", false, false, "jpg"},
+		{"image/*", true, false, "png"},
+		{"", true, false, "png"},
+		{webp, false, false, "jpg"},
+		{webp, true, false, "webp"},
+		{"image/webp;q=0", true, false, "png"},
+		{"image/webp;q=0", false, false, "jpg"},
+		{"", false, true, "gif"},
+		{webp, true, true, "gif"},
+	}
+	for _, tt := range tests {
+		if got := ChooseFormat(tt.accept, tt.alpha, tt.animated).Name; got != tt.want {
+			t.Errorf("ChooseFormat(%q, alpha %v, animated %v) = %s, want %s", tt.accept, tt.alpha, tt.animated, got, tt.want)
+		}
+	}
+}
+
+func TestAutoFormat(t *testing.T) {
+	opaque := &Source{Image: uniform(4, 4, red), Format: "png"}
+	alpha := &Source{Image: testImage(4, 4), Format: "png"}
+	anim := &Source{Image: uniform(4, 4, red), Format: "gif", Anim: &gif.GIF{}}
+	tests := []struct {
+		src    *Source
+		query  string
+		accept string
+		want   string
+	}{
+		{opaque, "?format=auto", "", "jpg"},
+		{opaque, "?format=auto", "image/webp", "jpg"},
+		{alpha, "?format=auto", "image/webp", "webp"},
+		{alpha, "?format=auto", "", "png"},
+		{opaque, "?format=auto&corner-radius=4", "", "png"},
+		{opaque, "?format=auto&mask=circle", "*/*", "png"},
+		{opaque, "?format=auto&lossless=true", "", "png"},
+		{opaque, "?format=auto&lossless=true", "image/webp", "webp"},
+		{opaque, "?format=auto&colors=8", "image/webp", "png"},
+		{anim, "?format=auto", "image/webp", "gif"},
+		{anim, "?format=auto&frame=2", "image/webp", "jpg"},
+		{anim, "?format=auto&frame=2&mask=circle", "image/webp", "webp"},
+		{anim, "?format=auto&frame=2", "", "jpg"},
+	}
+	for _, tt := range tests {
+		o, err := parseOptions(tt.query)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.query, err)
+		}
+		if got := AutoFormat(tt.accept, tt.src, o).Name; got != tt.want {
+			t.Errorf("AutoFormat(%q) for %s %s = %s, want %s", tt.accept, tt.src.Format, tt.query, got, tt.want)
+		}
+	}
+}
+
+func TestHandlerAutoFormat(t *testing.T) {
+	dir := t.TempDir()
+	writePNG(t, dir, "a.png", uniform(8, 6, red))
+	h := &Handler{Engine: &Engine{Config: DefaultConfig, Origin: Dir(dir)}}
+	tests := []struct {
+		url, accept string
+		mediaType   string
+		vary        bool
+	}{
+		{"/a.png?format=auto", "image/webp,*/*", "image/jpeg", true},
+		{"/a.png?format=auto&corner-radius=2", "image/webp,*/*", "image/webp", true},
+		{"/a.png?format=auto", "*/*", "image/jpeg", true},
+		{"/a.png?format=auto&corner-radius=2", "*/*", "image/png", true},
+		{"/a.png?w=4", "image/webp", "image/png", false},
+	}
+	for _, tt := range tests {
+		r := httptest.NewRequest("GET", tt.url, nil)
+		r.Header.Set("Accept", tt.accept)
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, r)
+		if rec.Code != 200 {
+			t.Fatalf("%s: status %d", tt.url, rec.Code)
+		}
+		if got := rec.Header().Get("Content-Type"); got != tt.mediaType {
+			t.Errorf("%s with %q: Content-Type %s, want %s", tt.url, tt.accept, got, tt.mediaType)
+		}
+		if got := rec.Header().Get("Vary") == "Accept"; got != tt.vary {
+			t.Errorf("%s: Vary %q", tt.url, rec.Header().Get("Vary"))
+		}
+		if _, _, err := image.Decode(rec.Body); err != nil {
+			t.Errorf("%s: %v", tt.url, err)
+		}
+	}
+}
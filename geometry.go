@@ -0,0 +1,249 @@
+package main
+
+import (
+	"fmt"
+	"image"
+	"math"
+)
+
+// Layout says where the pixels of a source end up.
+type Layout struct {
+	Crop   image.Rectangle // region of the source that is kept
+	Size   image.Point     // size Crop is resampled to
+	Canvas image.Point     // output size
+	Offset image.Point     // where Size is drawn on Canvas
+	Border int             // width of the border along the Canvas edges
+	Factor float64         // scale and dpr applied to pixel sizes given in the query
+}
+
+// Layout resolves the options against a source of the given size.
+//
+// The fit modes are those of FitSize, applied to the crop(...) region.
+// ar fills in a missing width or height. With neither given, fit=crop
+// cuts the source to that ratio and the other fits use the smallest box
+// of that ratio around it, so fit=scale stretches and fit=clip/max
+// letterbox. fit=clip/max letterbox onto bg when it is given.
+//
+// pad and border are carved out of the requested width and height, so
+// the canvas keeps the requested size. fit=clip/max letterbox whenever
+// pad or border are given.
+//
+// scale and dpr then multiply the output. crop(...) stays in source
+// pixels. dpr never enlarges past the cropped source, and the canvas is
+// capped by c.MaxWidth and c.MaxHeight. With upscale=false, or
+// c.Upscale false and no upscale given, nothing enlarges past the
+// cropped source.
+//
+// A crop(...) that misses the source is an error.
+func (o *Options) Layout(src image.Point, c Config) (Layout, error) {
+	region := image.Rect(0, 0, src.X, src.Y)
+	if o.Crop != nil {
+		region = o.Crop.Rect(region)
+	}
+	if region.Empty() {
+		if o.Crop == nil {
+			return Layout{}, fmt.Errorf("crop: the source is empty")
+		}
+		return Layout{}, fmt.Errorf("crop: (x%d,y%d,w%d,h%d) is outside the %dx%d source",
+			o.Crop.X, o.Crop.Y, o.Crop.Width, o.Crop.Height, src.X, src.Y)
+	}
+
+	w, h := o.Width, o.Height
+	if o.AspectRatio > 0 {
+		switch {
+		case w > 0 && h == 0:
+			h = w / o.AspectRatio
+		case h > 0 && w == 0:
+			w = h * o.AspectRatio
+		case w == 0 && h == 0 && o.Fit == "crop":
+			region = cropToRatio(region, o.AspectRatio)
+		case w == 0 && h == 0:
+			w, h = ratioBox(region.Size(), o.AspectRatio)
+		}
+	}
+
+	inset := o.Pad
+	inset.Top += o.Border
+	inset.Right += o.Border
+	inset.Bottom += o.Border
+	inset.Left += o.Border
+	if w > 0 {
+		w = math.Max(w-float64(inset.Left+inset.Right), 1)
+	}
+	if h > 0 {
+		h = math.Max(h-float64(inset.Top+inset.Bottom), 1)
+	}
+
+	// Sizes stay fractional until the end, so rounding is not multiplied
+	// by scale and dpr.
+	w, h = fillBox(region.Size(), w, h)
+	l := Layout{}
+	sw, sh, crop := fitSize(region, w, h, o.Fit)
+	l.Crop = crop
+	upscale := c.Upscale
+	if o.Upscale != nil {
+		upscale = *o.Upscale
+	}
+	if !upscale {
+		k := math.Min(1, room(sw, sh, crop))
+		sw, sh = sw*k, sh*k
+	}
+
+	cw, ch := sw, sh
+	letterbox := o.Background != nil || inset != Sides{}
+	if letterbox && (o.Fit == "clip" || o.Fit == "max") {
+		cw, ch = w, h
+	}
+
+	f := 1.0
+	if o.Scale > 0 {
+		f = o.Scale
+	}
+	f *= dprFactor(sw, sh, crop, o.DPR)
+	if !upscale {
+		f = math.Min(f, room(sw, sh, crop))
+	}
+	outerW := cw + float64(inset.Left+inset.Right)
+	outerH := ch + float64(inset.Top+inset.Bottom)
+	if c.MaxWidth > 0 && outerW*f > float64(c.MaxWidth) {
+		f = float64(c.MaxWidth) / outerW
+	}
+	if c.MaxHeight > 0 && outerH*f > float64(c.MaxHeight) {
+		f = float64(c.MaxHeight) / outerH
+	}
+
+	l.Size = roundPoint(sw*f, sh*f)
+	inner := roundPoint(cw*f, ch*f)
+	topLeft := image.Pt(round(float64(inset.Left)*f), round(float64(inset.Top)*f))
+	bottomRight := image.Pt(round(float64(inset.Right)*f), round(float64(inset.Bottom)*f))
+	l.Canvas = topLeft.Add(inner).Add(bottomRight)
+	l.Offset = topLeft.Add(inner.Sub(l.Size).Div(2))
+	l.Border = round(float64(o.Border) * f)
+	l.Factor = f
+	return l, nil
+}
+
+// FitSize is what fit does to a source of size src asked for width by
+// height at a device pixel ratio of dpr. It returns the size the image
+// is resampled to and the part of the source that is kept.
+//
+// A zero width or height is derived from the aspect ratio of src, and
+// when both are zero the box is src itself. Then:
+//
+//	clip   scale uniformly to fit inside the box; may enlarge
+//	max    like clip, but never enlarge
+//	scale  stretch to exactly the box, ignoring the aspect ratio
+//	crop   scale uniformly to cover the box and keep the centered part
+//	       of src with the aspect ratio of the box; the size is the box
+//
+// Any other fit behaves as clip. dpr multiplies the size, but never past
+// the kept part of src, and never below the size at a dpr of 1.
+//
+// So for any input, the size is at least 1x1; clip and max keep the
+// aspect ratio of src within rounding; scale and crop return the box
+// times the dpr factor; crop is a centered sub rectangle of src; and
+// nothing but clip, scale and crop of a box larger than src enlarges.
+func FitSize(src image.Point, width, height float64, fit string, dpr float64) (image.Point, image.Rectangle) {
+	region := image.Rectangle{Max: src}
+	if region.Empty() {
+		return image.Point{}, region
+	}
+	width, height = fillBox(src, width, height)
+	w, h, crop := fitSize(region, width, height, fit)
+	f := dprFactor(w, h, crop, dpr)
+	return roundPoint(w*f, h*f), crop
+}
+
+// fillBox derives a missing width or height from the aspect ratio of src.
+func fillBox(src image.Point, w, h float64) (float64, float64) {
+	sw, sh := float64(src.X), float64(src.Y)
+	switch {
+	case w == 0 && h == 0:
+		return sw, sh
+	case w == 0:
+		return sw * h / sh, h
+	case h == 0:
+		return w, sh * w / sw
+	}
+	return w, h
+}
+
+// fitSize is FitSize at a dpr of 1 for a box with both sides set, before
+// the size is rounded.
+func fitSize(region image.Rectangle, w, h float64, fit string) (float64, float64, image.Rectangle) {
+	cw, ch := float64(region.Dx()), float64(region.Dy())
+	switch fit {
+	case "scale":
+		return w, h, region
+	case "crop":
+		return w, h, cropToRatio(region, w/h)
+	case "max":
+		f := math.Min(math.Min(w/cw, h/ch), 1)
+		return cw * f, ch * f, region
+	}
+	f := math.Min(w/cw, h/ch)
+	return cw * f, ch * f, region
+}
+
+// dprFactor is the part of dpr that does not enlarge crop past a w by h
+// size.
+func dprFactor(w, h float64, crop image.Rectangle, dpr float64) float64 {
+	if dpr <= 1 {
+		return 1
+	}
+	return math.Min(dpr, math.Max(1, room(w, h, crop)))
+}
+
+// room is how far a w by h size can grow before it enlarges crop.
+func room(w, h float64, crop image.Rectangle) float64 {
+	return math.Min(float64(crop.Dx())/w, float64(crop.Dy())/h)
+}
+
+// Rect returns the crop rectangle clipped to bounds.
+func (c *CropOption) Rect(bounds image.Rectangle) image.Rectangle {
+	r := image.Rect(c.X, c.Y, c.X+c.Width, c.Y+c.Height).Add(bounds.Min)
+	if c.Width == 0 {
+		r.Max.X = bounds.Max.X
+	}
+	if c.Height == 0 {
+		r.Max.Y = bounds.Max.Y
+	}
+	return r.Intersect(bounds)
+}
+
+// ratioBox returns the smallest box of ratio r around size.
+func ratioBox(size image.Point, r float64) (float64, float64) {
+	w, h := float64(size.X), float64(size.Y)
+	if w/h > r {
+		return w, w / r
+	}
+	return h * r, h
+}
+
+// cropToRatio returns the largest centered rectangle of ratio r inside region.
+func cropToRatio(region image.Rectangle, r float64) image.Rectangle {
+	w, h := float64(region.Dx()), float64(region.Dy())
+	if w/h > r {
+		w = h * r
+	} else {
+		h = w / r
+	}
+	size := roundPoint(w, h)
+	min := region.Min.Add(region.Size().Sub(size).Div(2))
+	return image.Rectangle{min, min.Add(size)}
+}
+
+func round(x float64) int {
+	return int(x + 0.5)
+}
+
+func roundPoint(x, y float64) image.Point {
+	p := image.Pt(round(x), round(y))
+	if p.X < 1 {
+		p.X = 1
+	}
+	if p.Y < 1 {
+		p.Y = 1
+	}
+	return p
+}
@@ -0,0 +1,158 @@
+package main
+
+import (
+	"image"
+	"math/rand"
+	"testing"
+)
+
+// TestFitSizeProperties checks the promises of the FitSize doc comment on
+// random input.
+func TestFitSizeProperties(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	fits := []string{"clip", "max", "scale", "crop"}
+	dprs := []float64{1, 1.5, 2, 3, 5}
+	side := func() float64 {
+		if r.Intn(8) == 0 {
+			return 0
+		}
+		return float64(1 + r.Intn(4000))
+	}
+	for i := 0; i < 100000; i++ {
+		src := image.Pt(1+r.Intn(4000), 1+r.Intn(4000))
+		w, h := side(), side()
+		fit := fits[r.Intn(len(fits))]
+		dpr := dprs[r.Intn(len(dprs))]
+		size, crop := FitSize(src, w, h, fit, dpr)
+		one, _ := FitSize(src, w, h, fit, 1)
+		fail := func(what string) {
+			t.Fatalf("FitSize(%v, %g, %g, %s, %g) = %v, %v: %s", src, w, h, fit, dpr, size, crop, what)
+		}
+
+		if size.X < 1 || size.Y < 1 {
+			fail("smaller than 1x1")
+		}
+		if !crop.In(image.Rectangle{Max: src}) || crop.Empty() {
+			fail("crop is not inside the source")
+		}
+		if dx, dy := crop.Min.X-(src.X-crop.Max.X), crop.Min.Y-(src.Y-crop.Max.Y); dx < -1 || dx > 1 || dy < -1 || dy > 1 {
+			fail("crop is not centered")
+		}
+		if size.X < one.X || size.Y < one.Y {
+			fail("dpr shrank the size")
+		}
+		if size.X > max(one.X, crop.Dx()) || size.Y > max(one.Y, crop.Dy()) {
+			fail("dpr enlarged past the kept part of the source")
+		}
+
+		switch fit {
+		case "clip", "max":
+			if crop != (image.Rectangle{Max: src}) {
+				fail("cropped")
+			}
+			// Each side is off by at most half a pixel from the exact
+			// ratio, unless it was raised to 1.
+			skew := float64(size.X*src.Y - size.Y*src.X)
+			if size.X > 1 && size.Y > 1 && 2*abs(skew) > float64(src.X+src.Y) {
+				fail("aspect ratio not kept")
+			}
+			if fit == "max" && (size.X > src.X || size.Y > src.Y) {
+				fail("max enlarged")
+			}
+		case "scale", "crop":
+			bw, bh := fillBox(src, w, h)
+			if dpr == 1 && one != roundPoint(bw, bh) {
+				fail("not the box")
+			}
+		}
+	}
+}
+
+func abs(x float64) float64 {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
+
+func TestLayoutRoundsOnce(t *testing.T) {
+	o := &Options{Width: 1658, Height: 354, Fit: "clip", DPR: 5}
+	l, err := o.Layout(image.Pt(303, 2257), DefaultConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := image.Pt(238, 1770); l.Size != want || l.Canvas != want {
+		t.Errorf("size %v, canvas %v, want %v", l.Size, l.Canvas, want)
+	}
+	if size, _ := FitSize(image.Pt(303, 2257), 1658, 354, "clip", 5); size != image.Pt(238, 1770) {
+		t.Errorf("FitSize: %v, want (238,1770)", size)
+	}
+}
+
+// TestLayoutProperties checks that the canvas holds the resampled image
+// and keeps within the server caps, on random input.
+func TestLayoutProperties(t *testing.T) {
+	r := rand.New(rand.NewSource(2))
+	fits := []string{"clip", "max", "scale", "crop"}
+	for i := 0; i < 50000; i++ {
+		src := image.Pt(1+r.Intn(3000), 1+r.Intn(3000))
+		o := &Options{
+			Width:  float64(r.Intn(3000)),
+			Height: float64(r.Intn(3000)),
+			Fit:    fits[r.Intn(len(fits))],
+			DPR:    1 + float64(r.Intn(5)),
+			Pad:    Sides{r.Intn(20), r.Intn(20), r.Intn(20), r.Intn(20)},
+		}
+		l, err := o.Layout(src, DefaultConfig)
+		if err != nil {
+			t.Fatal(err)
+		}
+		fail := func(what string) {
+			t.Fatalf("%+v on %v: layout %+v: %s", o, src, l, what)
+		}
+		if l.Size.X < 1 || l.Size.Y < 1 {
+			fail("empty size")
+		}
+		if !(image.Rectangle{l.Offset, l.Offset.Add(l.Size)}).In(image.Rectangle{Max: l.Canvas}) {
+			fail("the image is not on the canvas")
+		}
+		if l.Canvas.X > DefaultConfig.MaxWidth+1 || l.Canvas.Y > DefaultConfig.MaxHeight+1 {
+			fail("canvas past the caps")
+		}
+	}
+}
+
+func TestLayoutAspectRatio(t *testing.T) {
+	tests := []struct {
+		query        string
+		size, canvas image.Point
+		diagnostic   bool
+	}{
+		{"?ar=16:9&fit=clip&bg=000", image.Pt(1000, 1000), image.Pt(1778, 1000), false},
+		{"?ar=1:2&fit=max&bg=000", image.Pt(1000, 1000), image.Pt(1000, 2000), false},
+		{"?ar=16:9&fit=clip&pad=10", image.Pt(980, 980), image.Pt(1778, 1000), false},
+		{"?ar=16:9&fit=crop", image.Pt(1000, 563), image.Pt(1000, 563), false},
+		{"?ar=16:9&fit=scale", image.Pt(1778, 1000), image.Pt(1778, 1000), false},
+		{"?ar=16:9&fit=clip", image.Pt(1000, 1000), image.Pt(1000, 1000), true},
+		{"?ar=16:9&w=320", image.Pt(180, 180), image.Pt(180, 180), false},
+		{"?ar=16:9&w=320&fit=crop", image.Pt(320, 180), image.Pt(320, 180), false},
+		{"?ar=2&h=90&fit=scale", image.Pt(180, 90), image.Pt(180, 90), false},
+		{"?ar=2&w=50&h=50&fit=scale", image.Pt(50, 50), image.Pt(50, 50), true},
+	}
+	for _, tt := range tests {
+		o, err := parseOptions(tt.query)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.query, err)
+		}
+		l, err := o.Layout(image.Pt(1000, 1000), DefaultConfig)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.query, err)
+		}
+		if l.Size != tt.size || l.Canvas != tt.canvas {
+			t.Errorf("%s: size %v on %v, want %v on %v", tt.query, l.Size, l.Canvas, tt.size, tt.canvas)
+		}
+		if got := len(o.Diagnostics) > 0; got != tt.diagnostic {
+			t.Errorf("%s: diagnostics %q", tt.query, o.Diagnostics)
+		}
+	}
+}
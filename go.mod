@@ -0,0 +1,7 @@
+module peg-sample
+
+go 1.23.0
+
+require golang.org/x/image v0.25.0
+
+require golang.org/x/text v0.23.0 // indirect
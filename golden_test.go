@@ -0,0 +1,69 @@
+package main
+
+import (
+	"flag"
+	"image"
+	"image/color"
+	"image/png"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden images in testdata")
+
+// testImage is a w by h fixture: a gradient over all three channels,
+// with the lower right quarter half transparent.
+func testImage(w, h int) *image.NRGBA {
+	img := image.NewNRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			a := uint8(0xff)
+			if x >= w/2 && y >= h/2 {
+				a = 0x80
+			}
+			img.SetNRGBA(x, y, color.NRGBA{uint8(255 * x / w), uint8(255 * y / h), uint8(255 * (x + y) / (w + h)), a})
+		}
+	}
+	return img
+}
+
+// checkGolden compares img with testdata/name.png, allowing each channel
+// to be off by one for floating point differences between platforms.
+// go test -update writes the file instead.
+func checkGolden(t *testing.T, name string, img image.Image) {
+	t.Helper()
+	path := filepath.Join("testdata", name+".png")
+	got := toNRGBA(img)
+	if *update {
+		f, err := os.Create(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer f.Close()
+		if err := png.Encode(f, got); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	decoded, err := png.Decode(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := toNRGBA(decoded)
+	if got.Rect != want.Rect {
+		t.Fatalf("%s: size %v, want %v", name, got.Rect.Size(), want.Rect.Size())
+	}
+	for i := range got.Pix {
+		if d := int(got.Pix[i]) - int(want.Pix[i]); d < -1 || d > 1 {
+			x, y := i/4%got.Rect.Dx(), i/4/got.Rect.Dx()
+			t.Fatalf("%s: pixel (%d,%d) is %v, want %v", name, x, y, got.NRGBAAt(x, y), want.NRGBAAt(x, y))
+		}
+	}
+}
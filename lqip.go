@@ -0,0 +1,167 @@
+package main
+
+import (
+	"bytes"
+	"encoding/base64"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"math"
+)
+
+const (
+	// lqipSize is the longest side of a base64 placeholder.
+	lqipSize = 32
+	// lqipQuality is the jpeg quality of a base64 placeholder.
+	lqipQuality = 50
+	// blurhashSample bounds the image BlurHash looks at.
+	blurhashSample = 64
+)
+
+var textFormat = &Format{Name: "txt", MediaType: "text/plain"}
+
+// renderLqip writes a placeholder of the transformed image: its BlurHash
+// or a tiny blurred data URI. Like every output it depends on the source
+// and options alone, so it caches as any other derivative.
+func (e *Engine) renderLqip(w io.Writer, src *Source, o *Options) (*Format, error) {
+	img, err := e.Transform(src.Image, o)
+	if err != nil {
+		return nil, err
+	}
+	var s string
+	if o.Lqip == "blurhash" {
+		s = BlurHash(img)
+	} else if s, err = DataURI(img, o.NeedsAlpha()); err != nil {
+		return nil, err
+	}
+	_, err = io.WriteString(w, s)
+	return textFormat, err
+}
+
+// DataURI shrinks img to lqipSize, blurs it and returns it as a jpeg data
+// URI, or png when alpha must survive.
+func DataURI(img image.Image, alpha bool) (string, error) {
+	size, _ := FitSize(img.Bounds().Size(), lqipSize, lqipSize, "clip", 1)
+	tiny := toRGBA(Resize(img, size, "bilinear"))
+	blurRGBA(tiny, 1)
+
+	var buf bytes.Buffer
+	media := "image/png"
+	if alpha {
+		if err := png.Encode(&buf, tiny); err != nil {
+			return "", err
+		}
+	} else {
+		media = "image/jpeg"
+		if err := jpeg.Encode(&buf, Flatten(tiny, nil), &jpeg.Options{Quality: lqipQuality}); err != nil {
+			return "", err
+		}
+	}
+	return "data:" + media + ";base64," + base64.StdEncoding.EncodeToString(buf.Bytes()), nil
+}
+
+// BlurHash encodes img per the BlurHash spec, with 4x3 components, or
+// 3x4 for portrait images. Transparent pixels count as white.
+func BlurHash(img image.Image) string {
+	size := img.Bounds().Size()
+	cx, cy := 4, 3
+	if size.Y > size.X {
+		cx, cy = 3, 4
+	}
+	if size.X > blurhashSample || size.Y > blurhashSample {
+		size, _ = FitSize(size, blurhashSample, blurhashSample, "clip", 1)
+		img = Resize(img, size, "bilinear")
+	}
+	src := toNRGBA(Flatten(img, nil))
+	w, h := size.X, size.Y
+
+	linear := make([][3]float64, w*h)
+	for i := range linear {
+		for c := 0; c < 3; c++ {
+			linear[i][c] = srgbToLinear(src.Pix[i*4+c])
+		}
+	}
+
+	factors := make([][3]float64, 0, cx*cy)
+	for j := 0; j < cy; j++ {
+		for i := 0; i < cx; i++ {
+			norm := 2.0
+			if i == 0 && j == 0 {
+				norm = 1
+			}
+			var f [3]float64
+			for y := 0; y < h; y++ {
+				by := math.Cos(math.Pi * float64(j) * float64(y) / float64(h))
+				for x := 0; x < w; x++ {
+					basis := by * math.Cos(math.Pi*float64(i)*float64(x)/float64(w))
+					p := linear[y*w+x]
+					for c := 0; c < 3; c++ {
+						f[c] += basis * p[c]
+					}
+				}
+			}
+			scale := norm / float64(w*h)
+			factors = append(factors, [3]float64{f[0] * scale, f[1] * scale, f[2] * scale})
+		}
+	}
+
+	hash := base83(cx-1+(cy-1)*9, 1)
+	maximum := 1.0
+	if ac := factors[1:]; len(ac) > 0 {
+		actual := 0.0
+		for _, f := range ac {
+			for c := 0; c < 3; c++ {
+				actual = math.Max(actual, math.Abs(f[c]))
+			}
+		}
+		quantised := int(math.Max(0, math.Min(82, math.Floor(actual*166-0.5))))
+		maximum = float64(quantised+1) / 166
+		hash += base83(quantised, 1)
+	} else {
+		hash += base83(0, 1)
+	}
+
+	dc := factors[0]
+	hash += base83(linearToSRGB(dc[0])<<16|linearToSRGB(dc[1])<<8|linearToSRGB(dc[2]), 4)
+	for _, f := range factors[1:] {
+		var q [3]int
+		for c := 0; c < 3; c++ {
+			v := signPow(f[c]/maximum, 0.5)*9 + 9.5
+			q[c] = int(math.Max(0, math.Min(18, math.Floor(v))))
+		}
+		hash += base83(q[0]*19*19+q[1]*19+q[2], 2)
+	}
+	return hash
+}
+
+const base83Chars = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz#$%*+,-.:;=?@[]^_{|}~"
+
+func base83(v, length int) string {
+	out := make([]byte, length)
+	for i := length - 1; i >= 0; i-- {
+		out[i] = base83Chars[v%83]
+		v /= 83
+	}
+	return string(out)
+}
+
+func srgbToLinear(v uint8) float64 {
+	f := float64(v) / 255
+	if f <= 0.04045 {
+		return f / 12.92
+	}
+	return math.Pow((f+0.055)/1.055, 2.4)
+}
+
+func linearToSRGB(v float64) int {
+	v = clamp(v, 0, 1)
+	if v <= 0.0031308 {
+		return int(v*12.92*255 + 0.5)
+	}
+	return int((1.055*math.Pow(v, 1/2.4)-0.055)*255 + 0.5)
+}
+
+func signPow(v, exp float64) float64 {
+	return math.Copysign(math.Pow(math.Abs(v), exp), v)
+}
@@ -0,0 +1,125 @@
+package main
+
+import (
+	"bytes"
+	"encoding/base64"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"strings"
+	"testing"
+)
+
+// gradient is an opaque w x h image whose red follows x, green y and
+// blue both, rounded down.
+func gradient(w, h int) *image.NRGBA {
+	img := image.NewNRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			img.SetNRGBA(x, y, color.NRGBA{uint8(255 * x / w), uint8(255 * y / h), uint8(255 * (x + y) / (w + h)), 0xff})
+		}
+	}
+	return img
+}
+
+// TestBlurHash compares with hashes from the C reference encoder of
+// woltapp/blurhash. A single white pixel can be worked out by hand: every
+// basis is 1, so DC is FFFFFF ("TSUA") and each AC is 2 in every channel,
+// which caps the maximum at 82 ("~") and every AC at 18,18,18 ("~q").
+func TestBlurHash(t *testing.T) {
+	tests := []struct {
+		name string
+		img  image.Image
+		hash string
+	}{
+		{"white 1x1", uniform(1, 1, color.NRGBA{255, 255, 255, 255}), "L~TSUA~q~q~q~q~q~q~q~q~q~q~q"},
+		{"gradient 8x6", gradient(8, 6), "LiF$If31a^xuzFNKfRnQenf9fRf6"},
+		{"gradient 6x8", gradient(6, 8), "TiFF.|7pSJumRUa~f4fRfRxuSxa{"},
+	}
+	for _, tt := range tests {
+		if got := BlurHash(tt.img); got != tt.hash {
+			t.Errorf("%s: got %s, want %s", tt.name, got, tt.hash)
+		}
+	}
+
+	// Transparent pixels count as white.
+	transparent := uniform(1, 1, color.NRGBA{})
+	if got, want := BlurHash(transparent), BlurHash(uniform(1, 1, color.NRGBA{255, 255, 255, 255})); got != want {
+		t.Errorf("transparent: got %s, want %s", got, want)
+	}
+	// Larger images are sampled down first but keep the components.
+	if got := BlurHash(gradient(640, 480)); len(got) != 28 || got[0] != 'L' {
+		t.Errorf("640x480: got %s, want 4x3 components", got)
+	}
+}
+
+// decodeDataURI checks the prefix of uri and decodes its image.
+func decodeDataURI(t *testing.T, uri, media string) image.Image {
+	t.Helper()
+	prefix := "data:" + media + ";base64,"
+	if !strings.HasPrefix(uri, prefix) {
+		t.Fatalf("%.40s...: want prefix %s", uri, prefix)
+	}
+	data, err := base64.StdEncoding.DecodeString(uri[len(prefix):])
+	if err != nil {
+		t.Fatal(err)
+	}
+	var img image.Image
+	if media == "image/png" {
+		img, err = png.Decode(bytes.NewReader(data))
+	} else {
+		img, err = jpeg.Decode(bytes.NewReader(data))
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	return img
+}
+
+func TestDataURI(t *testing.T) {
+	src := gradient(200, 100)
+	uri, err := DataURI(src, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	img := decodeDataURI(t, uri, "image/jpeg")
+	if size := img.Bounds().Size(); size != image.Pt(32, 16) {
+		t.Errorf("size %v, want 32x16", size)
+	}
+	if e := meanError(Resize(src, image.Pt(32, 16), "bilinear"), img); e > 8 {
+		t.Errorf("mean error %.2f against the shrunk source", e)
+	}
+
+	masked := uniform(40, 40, color.NRGBA{0, 128, 0, 255})
+	for i := 0; i < 40*4*8; i += 4 {
+		masked.Pix[i+3] = 0
+	}
+	uri, err = DataURI(masked, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := toNRGBA(decodeDataURI(t, uri, "image/png"))
+	if c := got.NRGBAAt(16, 0); c.A != 0 {
+		t.Errorf("transparent top is %v", c)
+	}
+	if c := got.NRGBAAt(16, 31); c.A != 255 || c.G < 120 {
+		t.Errorf("opaque bottom is %v", c)
+	}
+}
+
+func TestLqipHandler(t *testing.T) {
+	dir := t.TempDir()
+	writePNG(t, dir, "a.png", gradient(8, 6))
+	h := &Handler{Engine: &Engine{Config: DefaultConfig, Origin: Dir(dir)}}
+	rec := get(t, h, "/a.png?lqip=blurhash")
+	if rec.Code != 200 {
+		t.Fatalf("status %d: %s", rec.Code, rec.Body)
+	}
+	if ct := rec.Header().Get("Content-Type"); !strings.HasPrefix(ct, "text/plain") {
+		t.Errorf("Content-Type %q", ct)
+	}
+	if got := rec.Body.String(); got != "LiF$If31a^xuzFNKfRnQenf9fRf6" {
+		t.Errorf("got %s", got)
+	}
+}
@@ -9,13 +9,25 @@ func (cm *Peg) AddParam(key, value string) {
 	cm.Params[key] = value
 }
 
-func (cm *Peg) AddCropSubParam(key, subKey, value string) {
-	cm.CropParams[subKey] = value
-	cm.Params[key] = cm.CropParams
+func (cm *Peg) AddTupleSubParam(subKey, value string) {
+	cm.TupleParams[subKey] = value
 }
 
+func (cm *Peg) AddTupleParam(key string) {
+	cm.Params[key] = cm.TupleParams
+	cm.TupleParams = map[string]interface{}{}
+}
+
+func (cm *Peg) AddTupleListParam(key string) {
+	tuples, _ := cm.Params[key].([]interface{})
+	cm.Params[key] = append(tuples, cm.TupleParams)
+	cm.TupleParams = map[string]interface{}{}
+}
+
+// SkipParam records a parameter the grammar does not know. It does not
+// log, as the server parses every request.
 func (cm *Peg) SkipParam(text string) {
-	log.Printf("SkipParam ======== %s", text)
+	cm.Skipped = append(cm.Skipped, text)
 }
 
 func main() {
@@ -30,6 +42,24 @@ func main() {
 		"?quality=1000",
 		"?quality=100",
 		"?quality=09&&&&&&quality=8&q=9.000&format=png&format=11&quality=100aa&progressive=true&progressive=19&width=100&width=true&width=hgoe&crop=(100,100)",
+		"?w=800&ar=16:9&fit=crop",
+		"?w=800&h=600&ar=1.5&fit=clip&bg=fff",
+		"?w=200&dpr=3&scale=1.0",
+		"?redact(x10,y20,w50,h30)&redact(x100,y20,w50,h30)&redact-mode=pixelate",
+		"?w=500&h=500&pad(t10,r5,b10,l5)&border=2,333&bg=fff",
+		"?w=800&mark=/logos/brand.png&mark-w=120&mark-align=bottom,right&mark-alpha=80",
+		"?w=800&exif=true&strip-gps=true",
+		"?format=json&w=400&ar=4:3&fit=crop",
+		"?crop(x0,y0,w200,h200)&palette=css&colors=5",
+		"?w=600&h=400&fit=crop&lqip=blurhash",
+		"?crop(x0,y0,w500,h500)&phash=p&format=json",
+		"?w=400&format=webp",
+		"?w=400&format=bmp",
+		"?w=200&fit=clip&reverse=flop&frame=3",
+		"?w=400&format=auto",
+		"?w=1200&format=jpg&q=80&max-bytes=50000",
+		"?w=64&format=png&colors=16&dither=true",
+		"?format=webp&lossless=true",
 		""}
 
 	p := &Peg{}
@@ -40,13 +70,16 @@ func main() {
 
 	p.Init()
 	p.Params = map[string]interface{}{}
-	p.CropParams = map[string]interface{}{}
+	p.TupleParams = map[string]interface{}{}
 	err := p.Parse()
 	if err != nil {
 		fmt.Printf("Oops, Error! cause: %v\n", err)
 	}
 
 	p.Execute()
+	for _, text := range p.Skipped {
+		log.Printf("SkipParam ======== %s", text)
+	}
 
 	log.Println(p.Params)
 	crop, ok := p.Params["crop"].(map[string]interface{})
@@ -55,6 +88,15 @@ func main() {
 		log.Println(crop["height"])
 	}
 
+	o, err := p.Options()
+	if err != nil {
+		fmt.Printf("Oops, Error! cause: %v\n", err)
+	} else {
+		for _, d := range o.Diagnostics {
+			log.Printf("Diagnostic ======== %s", d)
+		}
+	}
+
 
 	fmt.Println("Success!!!")
 }
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"image"
+	"math"
+)
+
+// Mask makes everything outside corner-radius and mask transparent.
+//
+// mask is ellipse, circle or the path of an image on origin whose alpha
+// is used, resampled with filter. The shape follows the bounds of img;
+// corner-radius is multiplied by factor.
+func Mask(img image.Image, origin Origin, o *Options, factor float64, filter string) (image.Image, error) {
+	if !o.NeedsAlpha() {
+		return img, nil
+	}
+	dst := toNRGBA(img)
+	w, h := float64(dst.Rect.Dx()), float64(dst.Rect.Dy())
+
+	var alpha *image.Alpha
+	if o.Mask != "" && o.Mask != "ellipse" && o.Mask != "circle" {
+		m, _, err := Fetch(origin, o.Mask)
+		if err != nil {
+			return nil, err
+		}
+		alpha = image.NewAlpha(dst.Rect)
+		resized := toNRGBA(Resize(m, dst.Rect.Size(), filter))
+		for i := range alpha.Pix {
+			alpha.Pix[i] = resized.Pix[i*4+3]
+		}
+	}
+
+	radius := float64(o.Radius) * factor
+	for y := 0; y < dst.Rect.Dy(); y++ {
+		for x := 0; x < dst.Rect.Dx(); x++ {
+			px, py := float64(x)+0.5-w/2, float64(y)+0.5-h/2
+			cover := 1.0
+			if radius > 0 {
+				cover *= coverage(roundedRectDistance(px, py, w/2, h/2, radius))
+			}
+			switch o.Mask {
+			case "ellipse":
+				cover *= coverage(ellipseDistance(px, py, w/2, h/2))
+			case "circle":
+				r := math.Min(w, h) / 2
+				cover *= coverage(math.Hypot(px, py) - r)
+			}
+			i := dst.PixOffset(x, y)
+			a := float64(dst.Pix[i+3]) * cover
+			if alpha != nil {
+				a = a * float64(alpha.Pix[alpha.PixOffset(x, y)]) / 255
+			}
+			dst.Pix[i+3] = uint8(a + 0.5)
+		}
+	}
+	return dst, nil
+}
+
+// coverage turns a signed distance to an edge into antialiased coverage.
+func coverage(d float64) float64 {
+	return clamp(0.5-d, 0, 1)
+}
+
+// roundedRectDistance is the signed distance from p to a rectangle of half
+// size hw, hh with corners of radius r, all centered on the origin.
+func roundedRectDistance(px, py, hw, hh, r float64) float64 {
+	r = math.Min(r, math.Min(hw, hh))
+	qx, qy := math.Abs(px)-hw+r, math.Abs(py)-hh+r
+	outside := math.Hypot(math.Max(qx, 0), math.Max(qy, 0))
+	inside := math.Min(math.Max(qx, qy), 0)
+	return outside + inside - r
+}
+
+// ellipseDistance approximates the signed distance from p to the ellipse
+// with half axes a and b.
+func ellipseDistance(px, py, a, b float64) float64 {
+	k := math.Hypot(px/a, py/b)
+	return (k - 1) * math.Min(a, b)
+}
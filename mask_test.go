@@ -0,0 +1,147 @@
+package main
+
+import (
+	"image"
+	"image/color"
+	"image/jpeg"
+	"math"
+	"testing"
+)
+
+// area is the sum of the alpha of img, in fully opaque pixels.
+func area(img image.Image) float64 {
+	n := toNRGBA(img)
+	sum := 0.0
+	for i := 3; i < len(n.Pix); i += 4 {
+		sum += float64(n.Pix[i]) / 255
+	}
+	return sum
+}
+
+func TestMaskShapes(t *testing.T) {
+	src := uniform(40, 20, color.NRGBA{0, 128, 0, 255})
+	tests := []struct {
+		name        string
+		o           Options
+		factor      float64
+		area        float64
+		transparent []image.Point
+		opaque      []image.Point
+	}{
+		{"circle", Options{Mask: "circle"}, 1, math.Pi * 10 * 10,
+			[]image.Point{{0, 0}, {9, 10}, {30, 10}, {39, 19}},
+			[]image.Point{{20, 10}, {11, 10}, {28, 10}, {20, 1}}},
+		{"ellipse", Options{Mask: "ellipse"}, 1, math.Pi * 20 * 10,
+			[]image.Point{{0, 0}, {39, 19}, {2, 2}},
+			[]image.Point{{20, 10}, {1, 10}, {38, 10}, {20, 1}}},
+		{"corner-radius", Options{Radius: 5}, 1, 800 - (4-math.Pi)*5*5,
+			[]image.Point{{0, 0}, {39, 0}, {0, 19}, {39, 19}},
+			[]image.Point{{20, 0}, {0, 10}, {5, 5}, {34, 14}}},
+		{"corner-radius scaled", Options{Radius: 5}, 2, 800 - (4-math.Pi)*10*10,
+			[]image.Point{{1, 1}, {38, 18}},
+			[]image.Point{{20, 0}, {1, 10}}},
+		{"circle and corner-radius", Options{Mask: "circle", Radius: 5}, 1, math.Pi * 10 * 10,
+			[]image.Point{{0, 0}},
+			[]image.Point{{20, 10}}},
+	}
+	for _, tt := range tests {
+		out, err := Mask(src, nil, &tt.o, tt.factor, "linear")
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		if a := area(out); math.Abs(a-tt.area) > tt.area/100 {
+			t.Errorf("%s: covers %.1f pixels, want %.1f", tt.name, a, tt.area)
+		}
+		got := toNRGBA(out)
+		for _, p := range tt.transparent {
+			if c := got.NRGBAAt(p.X, p.Y); c.A != 0 {
+				t.Errorf("%s: %v is %v, want transparent", tt.name, p, c)
+			}
+		}
+		for _, p := range tt.opaque {
+			if c := got.NRGBAAt(p.X, p.Y); c.A != 255 {
+				t.Errorf("%s: %v is %v, want opaque", tt.name, p, c)
+			}
+		}
+	}
+}
+
+func TestMaskKeepsAlpha(t *testing.T) {
+	src := uniform(20, 20, color.NRGBA{0, 128, 0, 128})
+	out, err := Mask(src, nil, &Options{Mask: "circle"}, 1, "linear")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := toNRGBA(out)
+	if c := got.NRGBAAt(10, 10); c.A != 128 {
+		t.Errorf("center is %v, want the source alpha 128", c)
+	}
+	if c := got.NRGBAAt(0, 0); c.A != 0 {
+		t.Errorf("corner is %v, want transparent", c)
+	}
+}
+
+func TestMaskImage(t *testing.T) {
+	dir := t.TempDir()
+	// A 4x4 mask whose left half is opaque, stretched over 40x20.
+	m := image.NewNRGBA(image.Rect(0, 0, 4, 4))
+	for y := 0; y < 4; y++ {
+		for x := 0; x < 2; x++ {
+			m.SetNRGBA(x, y, color.NRGBA{A: 255})
+		}
+	}
+	writePNG(t, dir, "m.png", m)
+	out, err := Mask(uniform(40, 20, color.NRGBA{0, 128, 0, 255}), Dir(dir), &Options{Mask: "m.png"}, 1, "nearest")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := toNRGBA(out)
+	if c := got.NRGBAAt(5, 10); c.A != 255 {
+		t.Errorf("left half is %v, want opaque", c)
+	}
+	if c := got.NRGBAAt(35, 10); c.A != 0 {
+		t.Errorf("right half is %v, want transparent", c)
+	}
+	if _, err := Mask(uniform(1, 1, color.NRGBA{A: 255}), Dir(dir), &Options{Mask: "missing.png"}, 1, "linear"); err == nil {
+		t.Error("a missing mask image gave no error")
+	}
+}
+
+// TestMaskFlatten checks formats without alpha flatten the masked-out
+// area onto bg, or white without one.
+func TestMaskFlatten(t *testing.T) {
+	dir := t.TempDir()
+	writePNG(t, dir, "a.png", uniform(160, 160, color.NRGBA{0, 128, 0, 255}))
+	h := &Handler{Engine: &Engine{Config: DefaultConfig, Origin: Dir(dir)}}
+	tests := []struct {
+		query  string
+		corner color.NRGBA
+	}{
+		{"mask=circle&format=jpg", color.NRGBA{255, 255, 255, 255}},
+		{"mask=circle&format=jpg&bg=ff0000", color.NRGBA{255, 0, 0, 255}},
+		{"corner-radius=40&format=jpg&bg=0000ff", color.NRGBA{0, 0, 255, 255}},
+	}
+	for _, tt := range tests {
+		rec := get(t, h, "/a.png?"+tt.query)
+		img, err := jpeg.Decode(rec.Body)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.query, err)
+		}
+		if c := color.NRGBAModel.Convert(img.At(0, 0)).(color.NRGBA); !near(c, tt.corner, 12) {
+			t.Errorf("%s: corner is %v, want about %v", tt.query, c, tt.corner)
+		}
+		if c := color.NRGBAModel.Convert(img.At(80, 80)).(color.NRGBA); !near(c, color.NRGBA{0, 128, 0, 255}, 12) {
+			t.Errorf("%s: center is %v, want about the source green", tt.query, c)
+		}
+	}
+}
+
+// near reports whether every channel of a and b differs by at most tol.
+func near(a, b color.NRGBA, tol int) bool {
+	for _, d := range []int{int(a.R) - int(b.R), int(a.G) - int(b.G), int(a.B) - int(b.B), int(a.A) - int(b.A)} {
+		if d < -tol || d > tol {
+			return false
+		}
+	}
+	return true
+}
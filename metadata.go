@@ -0,0 +1,135 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"image"
+	"image/color"
+	"io"
+	"strings"
+)
+
+// Metadata is what format=json returns instead of pixels.
+type Metadata struct {
+	Width       int                    `json:"width"`
+	Height      int                    `json:"height"`
+	Format      string                 `json:"format"`
+	ColorModel  string                 `json:"colorModel"`
+	Orientation int                    `json:"orientation"`
+	Frames      int                    `json:"frames"`
+	Exif        map[string]interface{} `json:"exif,omitempty"`
+	Trim        *CropOption            `json:"trim,omitempty"`
+	Output      Dimensions             `json:"output"`
+	Options     *Options               `json:"options"`
+}
+
+// Dimensions is a size in pixels.
+type Dimensions struct {
+	Width  int `json:"width"`
+	Height int `json:"height"`
+}
+
+// Metadata describes src and the size the other options would produce,
+// without rendering it. Width, height and orientation are those of the
+// upright source; location tags follow the strip-gps policy. With trim,
+// Trim is the part of the source inside its borders.
+func (e *Engine) Metadata(src *Source, o *Options) (*Metadata, error) {
+	b := src.Image.Bounds()
+	m := &Metadata{
+		Width:       b.Dx(),
+		Height:      b.Dy(),
+		Format:      src.Format,
+		ColorModel:  src.ColorModel,
+		Orientation: 1,
+		Frames:      1,
+		Options:     o,
+	}
+	if src.Anim != nil {
+		m.Frames = len(src.Anim.Image)
+	}
+	if src.Exif != nil {
+		m.Orientation = src.Exif.Orientation
+		m.Exif = src.Exif.Fields(e.Config.StripGPS || o.StripGPS)
+	}
+	size := b.Size()
+	if o.Trim != "" {
+		r := TrimRect(src.Image, o)
+		if !r.Empty() {
+			r = r.Sub(b.Min)
+			m.Trim = &CropOption{r.Min.X, r.Min.Y, r.Dx(), r.Dy()}
+			size = r.Size()
+		}
+	}
+	l, err := o.Layout(size, e.Config)
+	if err != nil {
+		return nil, err
+	}
+	m.Output = Dimensions{l.Canvas.X, l.Canvas.Y}
+	return m, nil
+}
+
+// WriteJSON writes v as indented JSON.
+func WriteJSON(w io.Writer, v interface{}) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
+
+// MarshalJSON writes the resolved options under their parameter names,
+// colors as hex.
+func (o *Options) MarshalJSON() ([]byte, error) {
+	type plain Options
+	v := struct {
+		*plain
+		Background  string   `json:"bg,omitempty"`
+		BorderColor string   `json:"border-color,omitempty"`
+		TextColor   string   `json:"txt-color,omitempty"`
+		TrimColor   string   `json:"trim-color,omitempty"`
+		Duotone     []string `json:"duotone,omitempty"`
+		Pad         *Sides   `json:"pad,omitempty"`
+	}{
+		plain:       (*plain)(o),
+		Background:  hexColor(o.Background),
+		BorderColor: hexColor(o.BorderColor),
+		TextColor:   hexColor(o.TextColor),
+		TrimColor:   hexColor(o.TrimColor),
+	}
+	for _, c := range o.Duotone {
+		v.Duotone = append(v.Duotone, hexColor(c))
+	}
+	if o.Pad != (Sides{}) {
+		v.Pad = &o.Pad
+	}
+	return json.Marshal(v)
+}
+
+// hexColor formats c as rrggbb, or rrggbbaa when it is not opaque.
+func hexColor(c color.Color) string {
+	if c == nil {
+		return ""
+	}
+	n := color.NRGBAModel.Convert(c).(color.NRGBA)
+	if n.A == 0xff {
+		return fmt.Sprintf("%02x%02x%02x", n.R, n.G, n.B)
+	}
+	return fmt.Sprintf("%02x%02x%02x%02x", n.R, n.G, n.B, n.A)
+}
+
+// colorModelName names the color model of img as reported in metadata.
+func colorModelName(img image.Image) string {
+	switch img.(type) {
+	case *image.YCbCr:
+		return "ycbcr"
+	case *image.CMYK:
+		return "cmyk"
+	case *image.Gray, *image.Gray16:
+		return "gray"
+	case *image.Paletted:
+		return "paletted"
+	case *image.NRGBA, *image.NRGBA64:
+		return "nrgba"
+	case *image.RGBA, *image.RGBA64:
+		return "rgba"
+	}
+	return strings.TrimPrefix(fmt.Sprintf("%T", img), "*image.")
+}
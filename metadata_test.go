@@ -0,0 +1,28 @@
+package main
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+	"testing"
+)
+
+func TestMetadataTrim(t *testing.T) {
+	img := image.NewNRGBA(image.Rect(0, 0, 40, 30))
+	draw.Draw(img, img.Rect, image.White, image.Point{}, draw.Src)
+	draw.Draw(img, image.Rect(5, 4, 25, 14), image.NewUniform(color.NRGBA{0x80, 0, 0, 0xff}), image.Point{}, draw.Src)
+	e := &Engine{Config: DefaultConfig}
+	m, err := e.Metadata(&Source{Image: img, Format: "png"}, &Options{Trim: "auto", Fit: "clip"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (CropOption{5, 4, 20, 10}); m.Trim == nil || *m.Trim != want {
+		t.Fatalf("trim %+v, want %+v", m.Trim, want)
+	}
+	if want := (Dimensions{20, 10}); m.Output != want {
+		t.Errorf("output %+v, want %+v", m.Output, want)
+	}
+	if m, _ := e.Metadata(&Source{Image: img, Format: "png"}, &Options{Fit: "clip"}); m.Trim != nil {
+		t.Errorf("trim %+v without trim", m.Trim)
+	}
+}
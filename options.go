@@ -0,0 +1,436 @@
+package main
+
+import (
+	"fmt"
+	"image/color"
+	"net/url"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// Options is the typed form of Params. Zero values mean "not given".
+type Options struct {
+	Format      string        `json:"format,omitempty"`
+	Progressive bool          `json:"progressive,omitempty"`
+	Width       float64       `json:"width,omitempty"`
+	Height      float64       `json:"height,omitempty"`
+	Fit         string        `json:"fit,omitempty"`
+	Scale       float64       `json:"scale,omitempty"`
+	Reverse     string        `json:"reverse,omitempty"`
+	Crop        *CropOption   `json:"crop,omitempty"`
+	Quality     int           `json:"quality,omitempty"`
+	Exif        bool          `json:"exif,omitempty"`
+	StripGPS    bool          `json:"strip-gps,omitempty"`
+	AspectRatio float64       `json:"ar,omitempty"`
+	Background  color.Color   `json:"bg,omitempty"`
+	DPR         float64       `json:"dpr,omitempty"`
+	Brightness  int           `json:"bri,omitempty"`
+	Contrast    int           `json:"con,omitempty"`
+	Saturation  int           `json:"sat,omitempty"`
+	Gamma       int           `json:"gam,omitempty"`
+	Hue         int           `json:"hue,omitempty"`
+	Mono        bool          `json:"mono,omitempty"`
+	Sepia       int           `json:"sepia,omitempty"`
+	Invert      bool          `json:"invert,omitempty"`
+	Duotone     []color.Color `json:"duotone,omitempty"`
+	Blur        int           `json:"blur,omitempty"`
+	Sharpen     int           `json:"sharp,omitempty"`
+	Pixelate    int           `json:"px,omitempty"`
+	Redact      []*CropOption `json:"redact,omitempty"`
+	RedactMode  string        `json:"redact-mode,omitempty"`
+	Pad         Sides         `json:"pad,omitempty"`
+	Border      int           `json:"border,omitempty"`
+	BorderColor color.Color   `json:"border-color,omitempty"`
+	Mark        string        `json:"mark,omitempty"`
+	MarkWidth   int           `json:"mark-w,omitempty"`
+	MarkAlign   string        `json:"mark-align,omitempty"`
+	MarkPad     int           `json:"mark-pad,omitempty"`
+	MarkAlpha   int           `json:"mark-alpha,omitempty"`
+	MarkScale   int           `json:"mark-scale,omitempty"`
+	Text        string        `json:"txt,omitempty"`
+	TextSize    int           `json:"txt-size,omitempty"`
+	TextColor   color.Color   `json:"txt-color,omitempty"`
+	TextAlign   string        `json:"txt-align,omitempty"`
+	TextPad     int           `json:"txt-pad,omitempty"`
+	TextFont    string        `json:"txt-font,omitempty"`
+	Radius      int           `json:"corner-radius,omitempty"`
+	Mask        string        `json:"mask,omitempty"`
+	Trim        string        `json:"trim,omitempty"`
+	TrimTol     int           `json:"trim-tol,omitempty"`
+	TrimColor   color.Color   `json:"trim-color,omitempty"`
+	Filter      string        `json:"filter,omitempty"`
+	Upscale     *bool         `json:"upscale,omitempty"`
+	Palette     string        `json:"palette,omitempty"`
+	Colors      int           `json:"colors,omitempty"`
+	Lqip        string        `json:"lqip,omitempty"`
+	PHash       string        `json:"phash,omitempty"`
+	Frame       int           `json:"frame,omitempty"`
+	MaxBytes    int           `json:"max-bytes,omitempty"`
+	Lossless    bool          `json:"lossless,omitempty"`
+	Dither      bool          `json:"dither,omitempty"`
+
+	// Diagnostics holds parameters that were accepted but ignored or adjusted.
+	Diagnostics []string `json:"diagnostics,omitempty"`
+}
+
+// Sides are insets in output pixels, before dpr.
+type Sides struct {
+	Top    int `json:"top"`
+	Right  int `json:"right"`
+	Bottom int `json:"bottom"`
+	Left   int `json:"left"`
+}
+
+// CropOption is the crop(...) rectangle in source pixels.
+// A zero Width or Height runs to the edge of the source.
+type CropOption struct {
+	X      int `json:"x"`
+	Y      int `json:"y"`
+	Width  int `json:"width"`
+	Height int `json:"height"`
+}
+
+// Options converts Params into Options.
+func (cm *Peg) Options() (*Options, error) {
+	o := &Options{
+		Fit:        "clip",
+		RedactMode: "fill",
+		MarkAlign:  "bottom,right",
+		MarkPad:    10,
+		MarkAlpha:  100,
+		TextSize:   24,
+		TextColor:  color.Black,
+		TextAlign:  "bottom,right",
+		TextPad:    10,
+		TextFont:   "regular",
+		TrimTol:    10,
+		TrimColor:  color.White,
+	}
+	var err error
+
+	for key, value := range cm.Params {
+		text, _ := value.(string)
+		switch key {
+		case "format":
+			o.Format = text
+			f, ok := LookupFormat(text)
+			switch {
+			case text == "auto":
+			case !ok:
+				err = fmt.Errorf("format: unsupported format %q", text)
+			case !f.Writable():
+				err = fmt.Errorf("format: %s can be read but not written", text)
+			}
+		case "progressive":
+			o.Progressive = text == "true"
+		case "width":
+			o.Width, err = parseNumber(key, text)
+		case "height":
+			o.Height, err = parseNumber(key, text)
+		case "fit":
+			o.Fit = text
+		case "scale":
+			o.Scale, err = parseNumber(key, text)
+		case "reverse":
+			o.Reverse = text
+		case "crop":
+			o.Crop, err = parseCrop(key, value)
+		case "quality":
+			o.Quality, err = parseSigned(key, text, 0, 100)
+		case "exif":
+			o.Exif = text == "true"
+		case "strip-gps":
+			o.StripGPS = text == "true"
+		case "ar":
+			o.AspectRatio, err = parseRatio(text)
+		case "bg":
+			o.Background, err = parseHexColor(key, text)
+		case "dpr":
+			o.DPR, err = parseNumber(key, text)
+			if err == nil && (o.DPR < 1 || o.DPR > 5) {
+				err = fmt.Errorf("dpr: %s is out of range 1..5", text)
+			}
+		case "bri":
+			o.Brightness, err = parseSigned(key, text, -100, 100)
+		case "con":
+			o.Contrast, err = parseSigned(key, text, -100, 100)
+		case "sat":
+			o.Saturation, err = parseSigned(key, text, -100, 100)
+		case "gam":
+			o.Gamma, err = parseSigned(key, text, -100, 100)
+		case "hue":
+			o.Hue, err = parseSigned(key, text, -100, 100)
+		case "sign-separator":
+			// bri-50 could mean -50 or 50, so only '=' is accepted.
+			err = fmt.Errorf("%s: write the value after '=', as in %s=%s", text, text[:3], text[4:])
+		case "mono":
+			o.Mono = text == "true"
+		case "sepia":
+			o.Sepia, err = parseSigned(key, text, 0, 100)
+		case "invert":
+			o.Invert = text == "true"
+		case "duotone":
+			o.Duotone, err = parseColors(key, text)
+		case "blur":
+			o.Blur, err = parseSigned(key, text, 0, 2000)
+		case "sharp":
+			o.Sharpen, err = parseSigned(key, text, 0, 100)
+		case "px":
+			o.Pixelate, err = parseSigned(key, text, 0, 100)
+		case "redact":
+			regions, _ := value.([]interface{})
+			for _, region := range regions {
+				var c *CropOption
+				if c, err = parseCrop(key, region); err != nil {
+					break
+				}
+				o.Redact = append(o.Redact, c)
+			}
+		case "redact-mode":
+			o.RedactMode = text
+		case "pad":
+			o.Pad, err = parsePad(key, value)
+		case "border":
+			o.Border, o.BorderColor, err = parseBorder(key, text)
+		case "mark":
+			if o.Mark, err = url.PathUnescape(text); err != nil {
+				err = fmt.Errorf("mark: invalid path %q", text)
+			}
+		case "mark-w":
+			o.MarkWidth, err = parseSigned(key, text, 0, DefaultConfig.MaxWidth)
+		case "mark-align":
+			o.MarkAlign = text
+		case "mark-pad":
+			o.MarkPad, err = parseSigned(key, text, 0, DefaultConfig.MaxWidth)
+		case "mark-alpha":
+			o.MarkAlpha, err = parseSigned(key, text, 0, 100)
+		case "mark-scale":
+			o.MarkScale, err = parseSigned(key, text, 0, 100)
+		case "txt":
+			o.Text, err = url.QueryUnescape(text)
+			if err != nil || !utf8.ValidString(o.Text) {
+				err = fmt.Errorf("txt: invalid text %q", text)
+			}
+		case "txt-size":
+			o.TextSize, err = parseSigned(key, text, 1, DefaultConfig.MaxHeight)
+		case "txt-color":
+			o.TextColor, err = parseHexColor(key, text)
+		case "txt-align":
+			o.TextAlign = text
+		case "txt-pad":
+			o.TextPad, err = parseSigned(key, text, 0, DefaultConfig.MaxWidth)
+		case "txt-font":
+			o.TextFont = text
+			if _, ok := Fonts[text]; !ok {
+				err = fmt.Errorf("txt-font: unknown font %q", text)
+			}
+		case "corner-radius":
+			o.Radius, err = parseSigned(key, text, 0, DefaultConfig.MaxWidth)
+		case "mask":
+			if o.Mask, err = url.PathUnescape(text); err != nil {
+				err = fmt.Errorf("mask: invalid path %q", text)
+			}
+		case "trim":
+			o.Trim = text
+		case "trim-tol":
+			o.TrimTol, err = parseSigned(key, text, 0, 100)
+		case "trim-color":
+			o.TrimColor, err = parseHexColor(key, text)
+		case "filter":
+			o.Filter = text
+		case "upscale":
+			upscale := text == "true"
+			o.Upscale = &upscale
+		case "palette":
+			o.Palette = text
+		case "colors":
+			o.Colors, err = parseSigned(key, text, 1, 256)
+		case "lqip":
+			o.Lqip = text
+		case "phash":
+			o.PHash = text
+		case "frame":
+			o.Frame, err = parseSigned(key, text, 1, 65535)
+		case "max-bytes":
+			o.MaxBytes, err = parseSigned(key, text, 1, 1<<30)
+		case "lossless":
+			o.Lossless = text == "true"
+		case "dither":
+			o.Dither = text == "true"
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if o.AspectRatio > 0 && o.Width > 0 && o.Height > 0 {
+		o.Diagnostics = append(o.Diagnostics, "ar ignored: width and height are both given")
+		o.AspectRatio = 0
+	}
+	if o.AspectRatio > 0 && o.Width == 0 && o.Height == 0 && o.Fit != "crop" && o.Fit != "scale" &&
+		o.Background == nil && o.Pad == (Sides{}) && o.Border == 0 {
+		o.Diagnostics = append(o.Diagnostics, "ar ignored: fit="+o.Fit+" letterboxes only with bg, pad or border")
+	}
+	switch {
+	case o.Palette != "" && o.Colors > MaxPaletteColors:
+		return nil, fmt.Errorf("colors: %d is out of range 1..%d for palette", o.Colors, MaxPaletteColors)
+	case o.Palette == "" && o.Colors == 1:
+		return nil, fmt.Errorf("colors: 1 is out of range 2..256")
+	case o.Lossless && o.Quantized():
+		return nil, fmt.Errorf("lossless: cannot be combined with colors")
+	}
+	if o.Dither && !o.Quantized() {
+		o.Diagnostics = append(o.Diagnostics, "dither ignored: colors is not given")
+		o.Dither = false
+	}
+	return o, nil
+}
+
+// Quantized reports whether the output is reduced to colors entries.
+func (o *Options) Quantized() bool {
+	return o.Colors > 0 && o.Palette == ""
+}
+
+// NeedsAlpha reports whether the output has transparent pixels on purpose.
+func (o *Options) NeedsAlpha() bool {
+	return o.Radius > 0 || o.Mask != ""
+}
+
+func parseNumber(key, text string) (float64, error) {
+	n, err := strconv.ParseFloat(text, 64)
+	if err != nil || n < 0 {
+		return 0, fmt.Errorf("%s: invalid number %q", key, text)
+	}
+	return n, nil
+}
+
+func parseSigned(key, text string, min, max int) (int, error) {
+	n, err := strconv.ParseFloat(text, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%s: invalid number %q", key, text)
+	}
+	if n < float64(min) || n > float64(max) {
+		return 0, fmt.Errorf("%s: %s is out of range %d..%d", key, text, min, max)
+	}
+	return int(n), nil
+}
+
+// parseRatio accepts "W:H" or a decimal such as "1.5".
+func parseRatio(text string) (float64, error) {
+	if i := strings.IndexByte(text, ':'); i >= 0 {
+		w, err1 := strconv.ParseFloat(text[:i], 64)
+		h, err2 := strconv.ParseFloat(text[i+1:], 64)
+		if err1 != nil || err2 != nil || w == 0 || h == 0 {
+			return 0, fmt.Errorf("ar: invalid ratio %q", text)
+		}
+		return w / h, nil
+	}
+	r, err := strconv.ParseFloat(text, 64)
+	if err != nil || r == 0 {
+		return 0, fmt.Errorf("ar: invalid ratio %q", text)
+	}
+	return r, nil
+}
+
+// parseHexColor accepts RGB, RGBA, RRGGBB and RRGGBBAA.
+func parseHexColor(key, text string) (color.Color, error) {
+	hex := text
+	if len(hex) == 3 || len(hex) == 4 {
+		long := make([]byte, 0, 8)
+		for i := 0; i < len(hex); i++ {
+			long = append(long, hex[i], hex[i])
+		}
+		hex = string(long)
+	}
+	if len(hex) == 6 {
+		hex += "ff"
+	}
+	v, err := strconv.ParseUint(hex, 16, 32)
+	if err != nil || len(hex) != 8 {
+		return nil, fmt.Errorf("%s: invalid color %q", key, text)
+	}
+	return color.NRGBA{R: uint8(v >> 24), G: uint8(v >> 16), B: uint8(v >> 8), A: uint8(v)}, nil
+}
+
+// parseColors accepts a comma separated list of hex colors.
+func parseColors(key, text string) ([]color.Color, error) {
+	var colors []color.Color
+	for _, hex := range strings.Split(text, ",") {
+		c, err := parseHexColor(key, hex)
+		if err != nil {
+			return nil, err
+		}
+		colors = append(colors, c)
+	}
+	return colors, nil
+}
+
+func parseCrop(key string, value interface{}) (*CropOption, error) {
+	sub, _ := value.(map[string]interface{})
+	c := &CropOption{}
+	for subKey, v := range sub {
+		text, _ := v.(string)
+		n, err := parseNumber(key+" "+subKey, text)
+		if err != nil {
+			return nil, err
+		}
+		switch subKey {
+		case "x":
+			c.X = int(n)
+		case "y":
+			c.Y = int(n)
+		case "width":
+			c.Width = int(n)
+		case "height":
+			c.Height = int(n)
+		default:
+			return nil, fmt.Errorf("%s: unexpected %s", key, subKey)
+		}
+	}
+	return c, nil
+}
+
+// parsePad accepts a single number for all sides, or a (t,r,b,l) tuple.
+func parsePad(key string, value interface{}) (Sides, error) {
+	if text, ok := value.(string); ok {
+		n, err := parseNumber(key, text)
+		return Sides{int(n), int(n), int(n), int(n)}, err
+	}
+	sub, _ := value.(map[string]interface{})
+	var s Sides
+	for subKey, v := range sub {
+		text, _ := v.(string)
+		n, err := parseNumber(key+" "+subKey, text)
+		if err != nil {
+			return Sides{}, err
+		}
+		switch subKey {
+		case "top":
+			s.Top = int(n)
+		case "right":
+			s.Right = int(n)
+		case "bottom":
+			s.Bottom = int(n)
+		case "left":
+			s.Left = int(n)
+		default:
+			return Sides{}, fmt.Errorf("%s: unexpected %s", key, subKey)
+		}
+	}
+	return s, nil
+}
+
+// parseBorder accepts "<width>,<color>".
+func parseBorder(key, text string) (int, color.Color, error) {
+	i := strings.IndexByte(text, ',')
+	if i < 0 {
+		return 0, nil, fmt.Errorf("%s: invalid border %q", key, text)
+	}
+	n, err := parseNumber(key, text[:i])
+	if err != nil {
+		return 0, nil, err
+	}
+	c, err := parseHexColor(key, text[i+1:])
+	return int(n), c, err
+}
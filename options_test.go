@@ -0,0 +1,83 @@
+package main
+
+import (
+	"bytes"
+	"log"
+	"os"
+	"reflect"
+	"testing"
+)
+
+// parseOptions runs query, with its leading "?", through the grammar and
+// Options.
+func parseOptions(query string) (*Options, error) {
+	p := &Peg{}
+	p.Buffer = query
+	p.Init()
+	p.Params = map[string]interface{}{}
+	p.TupleParams = map[string]interface{}{}
+	if err := p.Parse(); err != nil {
+		return nil, err
+	}
+	p.Execute()
+	return p.Options()
+}
+
+func TestOptionsText(t *testing.T) {
+	tests := []struct {
+		query string
+		text  string
+		ok    bool
+	}{
+		{"?txt=hello+world", "hello world", true},
+		{"?txt=50%25off", "50%off", true},
+		{"?txt=%E2%9C%93", "✓", true},
+		{"?txt=50%off", "", false},
+		{"?txt=100%", "", false},
+		{"?txt=%ff", "", false},
+	}
+	for _, tt := range tests {
+		o, err := parseOptions(tt.query)
+		if !tt.ok {
+			if err == nil {
+				t.Errorf("%s: got text %q, want an error", tt.query, o.Text)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: %v", tt.query, err)
+		} else if o.Text != tt.text {
+			t.Errorf("%s: got text %q, want %q", tt.query, o.Text, tt.text)
+		}
+	}
+}
+
+// TestSkipParam checks unknown parameters are recorded, and that serving
+// them does not write to the log.
+func TestSkipParam(t *testing.T) {
+	p := &Peg{}
+	p.Buffer = "?foo=1&w=10&bar"
+	p.Init()
+	p.Params = map[string]interface{}{}
+	p.TupleParams = map[string]interface{}{}
+	if err := p.Parse(); err != nil {
+		t.Fatal(err)
+	}
+	p.Execute()
+	if want := []string{"foo=1", "bar"}; !reflect.DeepEqual(p.Skipped, want) {
+		t.Errorf("skipped %q, want %q", p.Skipped, want)
+	}
+
+	var buf bytes.Buffer
+	log.SetOutput(&buf)
+	defer log.SetOutput(os.Stderr)
+	dir := t.TempDir()
+	writePNG(t, dir, "a.png", testImage(8, 6))
+	h := &Handler{Engine: &Engine{Config: DefaultConfig, Origin: Dir(dir)}}
+	if rec := get(t, h, "/a.png?foo=1&w=4"); rec.Code != 200 {
+		t.Fatalf("status %d: %s", rec.Code, rec.Body)
+	}
+	if buf.Len() > 0 {
+		t.Errorf("the server logged %q", buf.String())
+	}
+}
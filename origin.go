@@ -0,0 +1,89 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+
+	_ "golang.org/x/image/bmp"
+	_ "golang.org/x/image/tiff"
+	_ "golang.org/x/image/webp"
+)
+
+// Origin is where source images come from. Every image a request refers
+// to, the main one as well as overlays, is read through the same Origin.
+type Origin interface {
+	Open(name string) (io.ReadCloser, error)
+}
+
+// Dir is an Origin serving the files below a local directory.
+type Dir string
+
+func (d Dir) Open(name string) (io.ReadCloser, error) {
+	name = path.Clean("/" + name)
+	if name == "/" {
+		return nil, errors.New("origin: empty path")
+	}
+	return os.Open(filepath.Join(string(d), filepath.FromSlash(name)))
+}
+
+// Source is a decoded source image.
+type Source struct {
+	Image      image.Image
+	Format     string // as reported by image.Decode
+	ColorModel string // of the decoded image, before orientation
+	Exif       *Exif  // nil when the source has none
+
+	// Anim holds every frame of an animated GIF; Image is then its
+	// first frame.
+	Anim *gif.GIF
+}
+
+// Load reads and decodes name from origin. A JPEG is turned upright by
+// its EXIF orientation here, so crop and every later stage see the image
+// the way it is meant to be displayed.
+func Load(origin Origin, name string) (*Source, error) {
+	r, err := origin.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	img, format, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	src := &Source{Image: img, Format: format, ColorModel: colorModelName(img)}
+	switch format {
+	case "jpeg":
+		if src.Exif = ReadExif(data); src.Exif != nil {
+			src.Image = Orient(img, src.Exif.Orientation)
+		}
+	case "gif":
+		if g, err := gif.DecodeAll(bytes.NewReader(data)); err == nil && len(g.Image) > 1 {
+			src.Anim = g
+			src.Image, _ = StillFrame(g, 1)
+		}
+	}
+	return src, nil
+}
+
+// Fetch reads and decodes name from origin.
+func Fetch(origin Origin, name string) (image.Image, string, error) {
+	src, err := Load(origin, name)
+	if err != nil {
+		return nil, "", err
+	}
+	return src.Image, src.Format, nil
+}
@@ -0,0 +1,234 @@
+package main
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"io"
+	"math"
+	"sort"
+)
+
+// DefaultColors is the palette size when colors is not given.
+const DefaultColors = 6
+
+// MaxPaletteColors bounds colors for palette output; up to 256 are
+// allowed when colors quantizes the image instead.
+const MaxPaletteColors = 16
+
+// paletteSample bounds the image Palette looks at; larger ones are
+// resampled down first.
+const paletteSample = 256
+
+var cssFormat = &Format{Name: "css", MediaType: "text/css"}
+
+// Swatch is one color of a palette and the share of pixels it stands for.
+type Swatch struct {
+	Color  color.NRGBA
+	Weight float64
+}
+
+// Palette returns up to n dominant colors of img, most common first.
+// Median cut picks the starting colors and a few k-means passes move
+// them onto the clusters, which a median split can cut through. Pixels
+// less than half opaque are ignored.
+func Palette(img image.Image, n int) []Swatch {
+	size := img.Bounds().Size()
+	if size.X > paletteSample || size.Y > paletteSample {
+		size, _ = FitSize(size, paletteSample, paletteSample, "clip", 1)
+		img = Resize(img, size, "bilinear")
+	}
+	src := toNRGBA(img)
+	var pixels [][3]uint8
+	for i := 0; i < len(src.Pix); i += 4 {
+		if src.Pix[i+3] >= 0x80 {
+			pixels = append(pixels, [3]uint8{src.Pix[i], src.Pix[i+1], src.Pix[i+2]})
+		}
+	}
+	return swatches(pixels, n)
+}
+
+// swatches clusters pixels into up to n colors, most common first.
+func swatches(pixels [][3]uint8, n int) []Swatch {
+	if len(pixels) == 0 || n < 1 {
+		return nil
+	}
+	boxes := medianCut(pixels, n)
+	centers := make([][3]float64, len(boxes))
+	for i, b := range boxes {
+		m := b.mean()
+		centers[i] = [3]float64{float64(m.R), float64(m.G), float64(m.B)}
+	}
+	counts := kmeans(pixels, centers, kmeansPasses)
+
+	var swatches []Swatch
+	for i, c := range centers {
+		if counts[i] == 0 {
+			continue
+		}
+		swatches = append(swatches, Swatch{
+			color.NRGBA{uint8(c[0] + 0.5), uint8(c[1] + 0.5), uint8(c[2] + 0.5), 0xff},
+			float64(counts[i]) / float64(len(pixels)),
+		})
+	}
+	sort.SliceStable(swatches, func(i, j int) bool {
+		return swatches[i].Weight > swatches[j].Weight
+	})
+	return swatches
+}
+
+// colorBox is a set of pixels median cut splits further.
+type colorBox [][3]uint8
+
+// medianCut splits pixels into at most n boxes, each time halving the
+// box with the widest channel range at its median.
+func medianCut(pixels [][3]uint8, n int) []colorBox {
+	boxes := []colorBox{pixels}
+	for len(boxes) < n {
+		best, channel, width := -1, 0, 0
+		for i, b := range boxes {
+			if c, w := b.widest(); w > width {
+				best, channel, width = i, c, w
+			}
+		}
+		if best < 0 {
+			break
+		}
+		b := boxes[best]
+		sort.Slice(b, func(i, j int) bool { return b[i][channel] < b[j][channel] })
+		mid := len(b) / 2
+		boxes[best] = b[:mid]
+		boxes = append(boxes, b[mid:])
+	}
+	return boxes
+}
+
+// widest returns the channel with the largest range and that range.
+func (b colorBox) widest() (int, int) {
+	if len(b) < 2 {
+		return 0, 0
+	}
+	lo, hi := b[0], b[0]
+	for _, p := range b {
+		for c := 0; c < 3; c++ {
+			if p[c] < lo[c] {
+				lo[c] = p[c]
+			}
+			if p[c] > hi[c] {
+				hi[c] = p[c]
+			}
+		}
+	}
+	channel, width := 0, 0
+	for c := 0; c < 3; c++ {
+		if w := int(hi[c]) - int(lo[c]); w > width {
+			channel, width = c, w
+		}
+	}
+	return channel, width
+}
+
+func (b colorBox) mean() color.NRGBA {
+	var sum [3]int
+	for _, p := range b {
+		for c := 0; c < 3; c++ {
+			sum[c] += int(p[c])
+		}
+	}
+	n := len(b)
+	return color.NRGBA{
+		uint8((sum[0] + n/2) / n),
+		uint8((sum[1] + n/2) / n),
+		uint8((sum[2] + n/2) / n),
+		0xff,
+	}
+}
+
+const kmeansPasses = 8
+
+// kmeans moves centers to the mean of the pixels nearest to them, passes
+// times or until nothing changes, and returns how many pixels each has.
+func kmeans(pixels [][3]uint8, centers [][3]float64, passes int) []int {
+	counts := make([]int, len(centers))
+	sums := make([][3]float64, len(centers))
+	for pass := 0; pass < passes; pass++ {
+		for i := range centers {
+			counts[i], sums[i] = 0, [3]float64{}
+		}
+		for _, p := range pixels {
+			best, dist := 0, math.MaxFloat64
+			for i, c := range centers {
+				dr, dg, db := float64(p[0])-c[0], float64(p[1])-c[1], float64(p[2])-c[2]
+				if d := dr*dr + dg*dg + db*db; d < dist {
+					best, dist = i, d
+				}
+			}
+			counts[best]++
+			for c := 0; c < 3; c++ {
+				sums[best][c] += float64(p[c])
+			}
+		}
+		moved := false
+		for i := range centers {
+			if counts[i] == 0 {
+				continue
+			}
+			for c := 0; c < 3; c++ {
+				m := sums[i][c] / float64(counts[i])
+				if math.Abs(m-centers[i][c]) > 0.5 {
+					moved = true
+				}
+				centers[i][c] = m
+			}
+		}
+		if !moved {
+			break
+		}
+	}
+	return counts
+}
+
+// renderPalette writes the palette of the transformed image, so crop and
+// fit decide which region the colors come from.
+func (e *Engine) renderPalette(w io.Writer, src *Source, o *Options) (*Format, error) {
+	img, err := e.Transform(src.Image, o)
+	if err != nil {
+		return nil, err
+	}
+	n := o.Colors
+	if n == 0 {
+		n = DefaultColors
+	}
+	f := formats["json"]
+	if o.Palette == "css" {
+		f = cssFormat
+	}
+	return f, WritePalette(w, Palette(img, n), o.Palette)
+}
+
+// WritePalette writes swatches as json or as css classes, .palette-N
+// for the text color and .palette-bg-N for the background.
+func WritePalette(w io.Writer, swatches []Swatch, mode string) error {
+	if mode == "css" {
+		for i, s := range swatches {
+			hex := hexColor(s.Color)
+			if _, err := fmt.Fprintf(w, ".palette-%d { color: #%s; }\n.palette-bg-%d { background-color: #%s; }\n", i, hex, i, hex); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	type entry struct {
+		Hex    string  `json:"hex"`
+		Red    uint8   `json:"red"`
+		Green  uint8   `json:"green"`
+		Blue   uint8   `json:"blue"`
+		Weight float64 `json:"weight"`
+	}
+	colors := []entry{}
+	for _, s := range swatches {
+		colors = append(colors, entry{"#" + hexColor(s.Color), s.Color.R, s.Color.G, s.Color.B, s.Weight})
+	}
+	return WriteJSON(w, map[string]interface{}{"colors": colors})
+}
@@ -0,0 +1,127 @@
+package main
+
+import (
+	"encoding/json"
+	"image"
+	"image/color"
+	"math"
+	"strings"
+	"testing"
+)
+
+var (
+	paletteRed    = color.NRGBA{200, 30, 30, 255}
+	paletteBlue   = color.NRGBA{20, 40, 200, 255}
+	paletteYellow = color.NRGBA{240, 220, 20, 255}
+)
+
+// bands is 60x40 with red rows 0..23, blue rows 24..33 and yellow rows
+// 34..39: 60%, 25% and 15% of the pixels.
+func bands() *image.NRGBA {
+	img := image.NewNRGBA(image.Rect(0, 0, 60, 40))
+	for y := 0; y < 40; y++ {
+		c := paletteRed
+		if y >= 34 {
+			c = paletteYellow
+		} else if y >= 24 {
+			c = paletteBlue
+		}
+		for x := 0; x < 60; x++ {
+			img.SetNRGBA(x, y, c)
+		}
+	}
+	return img
+}
+
+func TestPalette(t *testing.T) {
+	for _, n := range []int{3, 6} {
+		got := Palette(bands(), n)
+		want := []Swatch{{paletteRed, 0.6}, {paletteBlue, 0.25}, {paletteYellow, 0.15}}
+		if len(got) != len(want) {
+			t.Fatalf("n=%d: %d swatches %v, want %v", n, len(got), got, want)
+		}
+		for i := range want {
+			if got[i].Color != want[i].Color || math.Abs(got[i].Weight-want[i].Weight) > 1e-9 {
+				t.Errorf("n=%d: swatch %d is %v, want %v", n, i, got[i], want[i])
+			}
+		}
+	}
+
+	// Two colors merge red and yellow, the closer pair.
+	got := Palette(bands(), 2)
+	if len(got) != 2 || got[0].Weight != 0.75 || got[1].Color != paletteBlue {
+		t.Errorf("n=2: %v, want red and yellow at 0.75, then blue", got)
+	}
+
+	// Transparent pixels do not count.
+	img := bands()
+	for i := 0; i < 24*60*4; i += 4 {
+		img.Pix[i+3] = 0
+	}
+	got = Palette(img, 3)
+	if len(got) != 2 || got[0].Color != paletteBlue || math.Abs(got[0].Weight-0.625) > 1e-9 {
+		t.Errorf("with red transparent: %v, want blue at 0.625 and yellow", got)
+	}
+}
+
+func TestPaletteOutput(t *testing.T) {
+	dir := t.TempDir()
+	writePNG(t, dir, "a.png", bands())
+	h := &Handler{Engine: &Engine{Config: DefaultConfig, Origin: Dir(dir)}}
+
+	type entry struct {
+		Hex    string
+		Red    uint8
+		Green  uint8
+		Blue   uint8
+		Weight float64
+	}
+	tests := []struct {
+		query string
+		want  []entry
+	}{
+		{"palette=json", []entry{
+			{"#c81e1e", 200, 30, 30, 0.6},
+			{"#1428c8", 20, 40, 200, 0.25},
+			{"#f0dc14", 240, 220, 20, 0.15},
+		}},
+		{"palette=json&crop(x0,y24,w60,h16)", []entry{
+			{"#1428c8", 20, 40, 200, 0.625},
+			{"#f0dc14", 240, 220, 20, 0.375},
+		}},
+		{"palette=json&colors=1&crop(x10,y0,w30,h20)", []entry{
+			{"#c81e1e", 200, 30, 30, 1},
+		}},
+	}
+	for _, tt := range tests {
+		rec := get(t, h, "/a.png?"+tt.query)
+		if rec.Code != 200 {
+			t.Fatalf("%s: status %d: %s", tt.query, rec.Code, rec.Body)
+		}
+		if ct := rec.Header().Get("Content-Type"); !strings.HasPrefix(ct, "application/json") {
+			t.Errorf("%s: Content-Type %q", tt.query, ct)
+		}
+		var out struct{ Colors []entry }
+		if err := json.Unmarshal(rec.Body.Bytes(), &out); err != nil {
+			t.Fatalf("%s: %v", tt.query, err)
+		}
+		if len(out.Colors) != len(tt.want) {
+			t.Fatalf("%s: %v, want %v", tt.query, out.Colors, tt.want)
+		}
+		for i, w := range tt.want {
+			if c := out.Colors[i]; c.Hex != w.Hex || c.Red != w.Red || c.Green != w.Green || c.Blue != w.Blue || math.Abs(c.Weight-w.Weight) > 1e-9 {
+				t.Errorf("%s: color %d is %+v, want %+v", tt.query, i, c, w)
+			}
+		}
+	}
+
+	rec := get(t, h, "/a.png?palette=css&crop(x0,y24,w60,h16)")
+	if ct := rec.Header().Get("Content-Type"); !strings.HasPrefix(ct, "text/css") {
+		t.Errorf("palette=css: Content-Type %q", ct)
+	}
+	want := ".palette-0 { color: #1428c8; }\n.palette-bg-0 { background-color: #1428c8; }\n" +
+		".palette-1 { color: #f0dc14; }\n.palette-bg-1 { background-color: #f0dc14; }\n"
+	if got := rec.Body.String(); got != want {
+		t.Errorf("palette=css: got\n%s\nwant\n%s", got, want)
+	}
+}
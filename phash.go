@@ -0,0 +1,160 @@
+package main
+
+import (
+	"fmt"
+	"image"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+)
+
+// Hash is a 64 bit perceptual hash. Similar images have hashes a small
+// Distance apart; below about 10 they are usually the same picture.
+type Hash uint64
+
+func (h Hash) String() string {
+	return fmt.Sprintf("%016x", uint64(h))
+}
+
+// ParseHash reads a hash written by String.
+func ParseHash(s string) (Hash, error) {
+	v, err := strconv.ParseUint(s, 16, 64)
+	if err != nil || len(s) != 16 {
+		return 0, fmt.Errorf("phash: invalid hash %q", s)
+	}
+	return Hash(v), nil
+}
+
+// Distance is the number of bits h and other differ in, 0..64.
+func (h Hash) Distance(other Hash) int {
+	n := 0
+	for v := uint64(h ^ other); v != 0; v &= v - 1 {
+		n++
+	}
+	return n
+}
+
+// CompareHashes returns the Distance between two hashes in hex.
+func CompareHashes(a, b string) (int, error) {
+	ha, err := ParseHash(a)
+	if err != nil {
+		return 0, err
+	}
+	hb, err := ParseHash(b)
+	if err != nil {
+		return 0, err
+	}
+	return ha.Distance(hb), nil
+}
+
+// PerceptualHash hashes img with algorithm a (average), d (difference)
+// or p (DCT).
+func PerceptualHash(img image.Image, algorithm string) (Hash, error) {
+	switch algorithm {
+	case "a":
+		px := grayPixels(img, 8, 8)
+		mean := 0.0
+		for _, v := range px {
+			mean += v
+		}
+		return threshold(px, mean/64), nil
+	case "d":
+		px := grayPixels(img, 9, 8)
+		var h Hash
+		for y := 0; y < 8; y++ {
+			for x := 0; x < 8; x++ {
+				h <<= 1
+				if px[y*9+x] > px[y*9+x+1] {
+					h |= 1
+				}
+			}
+		}
+		return h, nil
+	case "p":
+		const n = 32
+		coef := dct2(grayPixels(img, n, n), n)
+		low := make([]float64, 0, 64)
+		for y := 0; y < 8; y++ {
+			low = append(low, coef[y*n:y*n+8]...)
+		}
+		sorted := append([]float64(nil), low[1:]...)
+		sort.Float64s(sorted)
+		return threshold(low, sorted[len(sorted)/2]), nil
+	}
+	return 0, fmt.Errorf("phash: unknown algorithm %q", algorithm)
+}
+
+// renderPHash writes the hash of the image after the geometry stages
+// only, so filters and overlays do not move it. format=json wraps it in
+// an object.
+func (e *Engine) renderPHash(w io.Writer, src *Source, o *Options) (*Format, error) {
+	img, _, err := e.Geometry(src.Image, o)
+	if err != nil {
+		return nil, err
+	}
+	h, err := PerceptualHash(img, o.PHash)
+	if err != nil {
+		return nil, err
+	}
+	if o.Format == "json" {
+		f := formats["json"]
+		return f, WriteJSON(w, map[string]string{"algorithm": o.PHash, "hash": h.String()})
+	}
+	_, err = io.WriteString(w, h.String())
+	return textFormat, err
+}
+
+// grayPixels resamples img to w x h and returns its luma.
+func grayPixels(img image.Image, w, h int) []float64 {
+	small := toNRGBA(Resize(img, image.Pt(w, h), "bilinear"))
+	px := make([]float64, w*h)
+	for i := range px {
+		p := small.Pix[i*4:]
+		px[i] = luma(float64(p[0]), float64(p[1]), float64(p[2]))
+	}
+	return px
+}
+
+// threshold sets one bit per value above t, first value highest.
+func threshold(values []float64, t float64) Hash {
+	var h Hash
+	for _, v := range values {
+		h <<= 1
+		if v > t {
+			h |= 1
+		}
+	}
+	return h
+}
+
+// dct2 is the unnormalized 2D DCT-II of an n x n block.
+func dct2(px []float64, n int) []float64 {
+	cos := make([]float64, n*n)
+	for k := 0; k < n; k++ {
+		for i := 0; i < n; i++ {
+			cos[k*n+i] = math.Cos(math.Pi / float64(n) * (float64(i) + 0.5) * float64(k))
+		}
+	}
+	rows := make([]float64, n*n)
+	for y := 0; y < n; y++ {
+		for k := 0; k < n; k++ {
+			sum := 0.0
+			for x := 0; x < n; x++ {
+				sum += px[y*n+x] * cos[k*n+x]
+			}
+			rows[y*n+k] = sum
+		}
+	}
+	out := make([]float64, n*n)
+	for x := 0; x < n; x++ {
+		for k := 0; k < n; k++ {
+			sum := 0.0
+			for y := 0; y < n; y++ {
+				sum += rows[y*n+x] * cos[k*n+y]
+			}
+			out[k*n+x] = sum
+		}
+	}
+	return out
+}
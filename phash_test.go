@@ -0,0 +1,133 @@
+package main
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"math"
+	"testing"
+)
+
+// scene stands in for a photo: soft bright discs at centers, given as
+// fractions of the size, over a dark gradient.
+func scene(w, h int, centers ...[2]float64) *image.NRGBA {
+	img := image.NewNRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			v := 60 * float64(x+y) / float64(w+h)
+			for _, c := range centers {
+				d := math.Hypot(float64(x)/float64(w)-c[0], float64(y)/float64(h)-c[1])
+				v += 255 * math.Max(0, 1-d*4)
+			}
+			g := uint8(clamp(v, 0, 255))
+			img.SetNRGBA(x, y, color.NRGBA{g, g, g / 2, 0xff})
+		}
+	}
+	return img
+}
+
+func TestPerceptualHash(t *testing.T) {
+	images := map[string]image.Image{
+		"left":  scene(400, 300, [2]float64{0.3, 0.6}),
+		"right": scene(400, 300, [2]float64{0.75, 0.3}),
+		"pair":  scene(400, 300, [2]float64{0.2, 0.2}, [2]float64{0.7, 0.8}),
+	}
+	// Variants of each image that must hash close to it.
+	variants := func(img image.Image) map[string]image.Image {
+		var buf bytes.Buffer
+		if err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: 30}); err != nil {
+			t.Fatal(err)
+		}
+		lossy, err := jpeg.Decode(&buf)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return map[string]image.Image{
+			"same":    img,
+			"smaller": Resize(img, image.Pt(100, 75), "bilinear"),
+			"larger":  Resize(img, image.Pt(800, 600), "bicubic"),
+			"jpeg":    lossy,
+		}
+	}
+
+	for _, algorithm := range []string{"a", "d", "p"} {
+		hashes := map[string]Hash{}
+		for name, img := range images {
+			h, err := PerceptualHash(img, algorithm)
+			if err != nil {
+				t.Fatal(err)
+			}
+			hashes[name] = h
+			for variant, v := range variants(img) {
+				hv, err := PerceptualHash(v, algorithm)
+				if err != nil {
+					t.Fatal(err)
+				}
+				d := h.Distance(hv)
+				if variant == "same" && d != 0 {
+					t.Errorf("%s %s: same image at distance %d", algorithm, name, d)
+				}
+				if d > 6 {
+					t.Errorf("%s %s: %s at distance %d, want at most 6", algorithm, name, variant, d)
+				}
+			}
+		}
+		for a, ha := range hashes {
+			for b, hb := range hashes {
+				if a < b {
+					if d := ha.Distance(hb); d < 16 {
+						t.Errorf("%s: %s and %s at distance %d, want at least 16", algorithm, a, b, d)
+					}
+				}
+			}
+		}
+	}
+
+	if _, err := PerceptualHash(gradient(8, 8), "x"); err == nil {
+		t.Error("algorithm x: got no error")
+	}
+}
+
+func TestParseHash(t *testing.T) {
+	for _, s := range []string{"0000000000000000", "ffffffffffffffff", "0123456789abcdef", "0123456789ABCDEF"} {
+		h, err := ParseHash(s)
+		if err != nil {
+			t.Errorf("%s: %v", s, err)
+			continue
+		}
+		if h2, _ := ParseHash(h.String()); h2 != h {
+			t.Errorf("%s: String gives %s, which parses to %s", s, h, h2)
+		}
+	}
+	for _, s := range []string{"", "0", "123456789abcdef", "0123456789abcdef0", "0123456789abcdeg", "-123456789abcdef", " 123456789abcdef", "0x23456789abcdef"} {
+		if h, err := ParseHash(s); err == nil {
+			t.Errorf("%q: got %s, want an error", s, h)
+		}
+	}
+}
+
+func TestCompareHashes(t *testing.T) {
+	tests := []struct {
+		a, b string
+		d    int
+	}{
+		{"0000000000000000", "0000000000000000", 0},
+		{"0000000000000000", "ffffffffffffffff", 64},
+		{"0000000000000001", "0000000000000003", 1},
+		{"f0f0f0f0f0f0f0f0", "0f0f0f0f0f0f0f0f", 64},
+		{"8000000000000000", "0000000000000001", 2},
+	}
+	for _, tt := range tests {
+		d, err := CompareHashes(tt.a, tt.b)
+		if err != nil || d != tt.d {
+			t.Errorf("%s %s: got %d, %v, want %d", tt.a, tt.b, d, err, tt.d)
+		}
+	}
+	if _, err := CompareHashes("0000000000000000", "zz"); err == nil {
+		t.Error("malformed second hash: got no error")
+	}
+	if _, err := CompareHashes("zz", "0000000000000000"); err == nil {
+		t.Error("malformed first hash: got no error")
+	}
+}
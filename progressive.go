@@ -0,0 +1,501 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"image"
+	"image/color"
+	"io"
+	"math"
+)
+
+// EncodeProgressiveJPEG writes img as a progressive JPEG. image/jpeg only
+// writes baseline, so this is a small encoder of its own: 4:2:0 YCbCr,
+// the Annex K tables scaled by quality like libjpeg, and spectral
+// selection scans (DC, then the low and high AC bands of luma, then
+// chroma) without successive approximation.
+func EncodeProgressiveJPEG(w io.Writer, img image.Image, quality int) error {
+	b := img.Bounds()
+	if b.Dx() > 0xffff || b.Dy() > 0xffff {
+		return errors.New("jpeg: image is too large")
+	}
+	if quality < 1 {
+		quality = 1
+	} else if quality > 100 {
+		quality = 100
+	}
+
+	e := &jpegEncoder{w: bufio.NewWriter(w)}
+	for i := range e.quant {
+		scaleQuant(&e.quant[i], &baseQuant[i], quality)
+	}
+	e.split(img)
+
+	e.writeMarker(0xd8, nil)
+	e.writeDQT()
+	e.writeSOF2(b.Dx(), b.Dy())
+	e.writeDHT()
+	for _, s := range progressiveScans {
+		e.writeScan(s)
+	}
+	e.writeMarker(0xd9, nil)
+	return e.w.Flush()
+}
+
+// jpegScan is one scan: components by index, and the coefficient band.
+type jpegScan struct {
+	comps  []int
+	ss, se int
+}
+
+var progressiveScans = []jpegScan{
+	{[]int{0, 1, 2}, 0, 0},
+	{[]int{0}, 1, 5},
+	{[]int{1}, 1, 63},
+	{[]int{2}, 1, 63},
+	{[]int{0}, 6, 63},
+}
+
+type jpegEncoder struct {
+	// w keeps the first write error, and Flush returns it, so the write
+	// methods do not check it.
+	w     *bufio.Writer
+	quant [2][64]int32
+
+	// blocks holds the quantized coefficients of each component in natural
+	// order, bw blocks per row, covering whole MCUs.
+	blocks [3][][64]int32
+	bw, bh [3]int
+	// cw, ch are the component sizes in blocks, without MCU padding.
+	cw, ch [3]int
+	mx, my int
+
+	bits  uint32
+	nbits uint
+}
+
+// split converts img to YCbCr, subsamples chroma and quantizes the DCT
+// of every block.
+func (e *jpegEncoder) split(img image.Image) {
+	b := img.Bounds()
+	w, h := b.Dx(), b.Dy()
+	e.mx, e.my = (w+15)/16, (h+15)/16
+
+	pw, ph := e.mx*16, e.my*16
+	ys := make([]float64, pw*ph)
+	cbs := make([]float64, pw*ph)
+	crs := make([]float64, pw*ph)
+	for y := 0; y < ph; y++ {
+		sy := y
+		if sy >= h {
+			sy = h - 1
+		}
+		for x := 0; x < pw; x++ {
+			sx := x
+			if sx >= w {
+				sx = w - 1
+			}
+			c := color.NRGBAModel.Convert(img.At(b.Min.X+sx, b.Min.Y+sy)).(color.NRGBA)
+			yy, cb, cr := color.RGBToYCbCr(c.R, c.G, c.B)
+			ys[y*pw+x], cbs[y*pw+x], crs[y*pw+x] = float64(yy), float64(cb), float64(cr)
+		}
+	}
+
+	e.bw[0], e.bh[0] = e.mx*2, e.my*2
+	e.cw[0], e.ch[0] = (w+7)/8, (h+7)/8
+	e.blocks[0] = e.transform(ys, pw, e.bw[0], e.bh[0], 1, &e.quant[0])
+	for i, plane := range [][]float64{cbs, crs} {
+		c := i + 1
+		e.bw[c], e.bh[c] = e.mx, e.my
+		e.cw[c], e.ch[c] = ((w+1)/2+7)/8, ((h+1)/2+7)/8
+		e.blocks[c] = e.transform(plane, pw, e.bw[c], e.bh[c], 2, &e.quant[1])
+	}
+}
+
+// transform cuts plane into bw x bh blocks, averaging step x step
+// pixels into one sample.
+func (e *jpegEncoder) transform(plane []float64, stride, bw, bh, step int, q *[64]int32) [][64]int32 {
+	out := make([][64]int32, bw*bh)
+	var s [64]float64
+	for by := 0; by < bh; by++ {
+		for bx := 0; bx < bw; bx++ {
+			for y := 0; y < 8; y++ {
+				for x := 0; x < 8; x++ {
+					sum := 0.0
+					for dy := 0; dy < step; dy++ {
+						for dx := 0; dx < step; dx++ {
+							px := (bx*8+x)*step + dx
+							py := (by*8+y)*step + dy
+							sum += plane[py*stride+px]
+						}
+					}
+					s[y*8+x] = sum/float64(step*step) - 128
+				}
+			}
+			fdct(&s)
+			blk := &out[by*bw+bx]
+			for i := range s {
+				blk[i] = int32(math.Floor(s[i]/float64(q[i]) + 0.5))
+			}
+		}
+	}
+	return out
+}
+
+var dctCos [8][8]float64
+
+func init() {
+	for x := 0; x < 8; x++ {
+		for u := 0; u < 8; u++ {
+			dctCos[x][u] = math.Cos(float64(2*x+1) * float64(u) * math.Pi / 16)
+		}
+	}
+}
+
+// fdct is the separable, orthonormal 8x8 forward DCT, in place.
+func fdct(s *[64]float64) {
+	var t [64]float64
+	for y := 0; y < 8; y++ {
+		for u := 0; u < 8; u++ {
+			sum := 0.0
+			for x := 0; x < 8; x++ {
+				sum += s[y*8+x] * dctCos[x][u]
+			}
+			t[y*8+u] = sum
+		}
+	}
+	for u := 0; u < 8; u++ {
+		for v := 0; v < 8; v++ {
+			sum := 0.0
+			for y := 0; y < 8; y++ {
+				sum += t[y*8+u] * dctCos[y][v]
+			}
+			cu, cv := 1.0, 1.0
+			if u == 0 {
+				cu = math.Sqrt2 / 2
+			}
+			if v == 0 {
+				cv = math.Sqrt2 / 2
+			}
+			s[v*8+u] = sum * cu * cv / 4
+		}
+	}
+}
+
+func scaleQuant(dst, base *[64]int32, quality int) {
+	scale := int32(200 - 2*quality)
+	if quality < 50 {
+		scale = int32(5000 / quality)
+	}
+	for i := range base {
+		q := (base[i]*scale + 50) / 100
+		if q < 1 {
+			q = 1
+		} else if q > 255 {
+			q = 255
+		}
+		dst[i] = q
+	}
+}
+
+func (e *jpegEncoder) writeScan(s jpegScan) {
+	header := []byte{byte(len(s.comps))}
+	for _, c := range s.comps {
+		table := byte(0x00)
+		if c > 0 {
+			table = 0x11
+		}
+		header = append(header, byte(c+1), table)
+	}
+	header = append(header, byte(s.ss), byte(s.se), 0)
+	e.writeMarker(0xda, header)
+
+	if s.ss == 0 {
+		var pred [3]int32
+		for my := 0; my < e.my; my++ {
+			for mx := 0; mx < e.mx; mx++ {
+				for _, c := range s.comps {
+					n := 1
+					if c == 0 {
+						n = 2
+					}
+					for y := 0; y < n; y++ {
+						for x := 0; x < n; x++ {
+							blk := &e.blocks[c][(my*n+y)*e.bw[c]+mx*n+x]
+							e.writeDC(blk[0]-pred[c], c)
+							pred[c] = blk[0]
+						}
+					}
+				}
+			}
+		}
+	} else {
+		c := s.comps[0]
+		for by := 0; by < e.ch[c]; by++ {
+			for bx := 0; bx < e.cw[c]; bx++ {
+				e.writeAC(&e.blocks[c][by*e.bw[c]+bx], s.ss, s.se, c)
+			}
+		}
+	}
+	e.flushBits()
+}
+
+func (e *jpegEncoder) writeDC(diff int32, comp int) {
+	t := &huffDC[0]
+	if comp > 0 {
+		t = &huffDC[1]
+	}
+	size, bits := category(diff)
+	e.emit(t.codes[size], t.sizes[size])
+	if size > 0 {
+		e.emit(bits, uint(size))
+	}
+}
+
+func (e *jpegEncoder) writeAC(blk *[64]int32, ss, se, comp int) {
+	t := &huffAC[0]
+	if comp > 0 {
+		t = &huffAC[1]
+	}
+	run := 0
+	for k := ss; k <= se; k++ {
+		v := blk[zigzag[k]]
+		if v == 0 {
+			run++
+			continue
+		}
+		for run > 15 {
+			e.emit(t.codes[0xf0], t.sizes[0xf0])
+			run -= 16
+		}
+		size, bits := category(v)
+		sym := byte(run<<4) | byte(size)
+		e.emit(t.codes[sym], t.sizes[sym])
+		e.emit(bits, uint(size))
+		run = 0
+	}
+	if run > 0 {
+		e.emit(t.codes[0x00], t.sizes[0x00])
+	}
+}
+
+// category returns the magnitude category of v and its additional bits.
+func category(v int32) (int, uint32) {
+	a := v
+	if a < 0 {
+		a = -a
+		v--
+	}
+	size := 0
+	for a > 0 {
+		size++
+		a >>= 1
+	}
+	return size, uint32(v) & (1<<uint(size) - 1)
+}
+
+func (e *jpegEncoder) emit(bits uint32, n uint) {
+	for n > 0 {
+		n--
+		e.bits = e.bits<<1 | (bits>>n)&1
+		e.nbits++
+		if e.nbits == 8 {
+			e.writeByte(byte(e.bits))
+			e.bits, e.nbits = 0, 0
+		}
+	}
+}
+
+// flushBits pads the last byte of a scan with 1 bits.
+func (e *jpegEncoder) flushBits() {
+	for e.nbits != 0 {
+		e.emit(1, 1)
+	}
+}
+
+func (e *jpegEncoder) writeByte(c byte) {
+	e.w.WriteByte(c)
+	if c == 0xff {
+		e.w.WriteByte(0)
+	}
+}
+
+func (e *jpegEncoder) writeMarker(marker byte, payload []byte) {
+	e.w.Write([]byte{0xff, marker})
+	if marker == 0xd8 || marker == 0xd9 {
+		return
+	}
+	n := len(payload) + 2
+	e.w.Write([]byte{byte(n >> 8), byte(n)})
+	e.w.Write(payload)
+}
+
+func (e *jpegEncoder) writeDQT() {
+	var p []byte
+	for i := range e.quant {
+		p = append(p, byte(i))
+		for k := 0; k < 64; k++ {
+			p = append(p, byte(e.quant[i][zigzag[k]]))
+		}
+	}
+	e.writeMarker(0xdb, p)
+}
+
+func (e *jpegEncoder) writeSOF2(w, h int) {
+	e.writeMarker(0xc2, []byte{
+		8, byte(h >> 8), byte(h), byte(w >> 8), byte(w), 3,
+		1, 0x22, 0,
+		2, 0x11, 1,
+		3, 0x11, 1,
+	})
+}
+
+func (e *jpegEncoder) writeDHT() {
+	var p []byte
+	for i, spec := range []*huffSpec{&dcSpecs[0], &acSpecs[0], &dcSpecs[1], &acSpecs[1]} {
+		class := byte(i % 2)
+		id := byte(i / 2)
+		p = append(p, class<<4|id)
+		p = append(p, spec.counts[:]...)
+		p = append(p, spec.values...)
+	}
+	e.writeMarker(0xc4, p)
+}
+
+// huffSpec is a Huffman table as stored in DHT: code counts per length
+// and the symbols in code order.
+type huffSpec struct {
+	counts [16]byte
+	values []byte
+}
+
+type huffTable struct {
+	codes [256]uint32
+	sizes [256]uint
+}
+
+func (s *huffSpec) build() (t huffTable) {
+	code, k := uint32(0), 0
+	for n := 0; n < 16; n++ {
+		for i := 0; i < int(s.counts[n]); i++ {
+			t.codes[s.values[k]] = code
+			t.sizes[s.values[k]] = uint(n + 1)
+			code++
+			k++
+		}
+		code <<= 1
+	}
+	return t
+}
+
+var huffDC, huffAC [2]huffTable
+
+func init() {
+	for i := range huffDC {
+		huffDC[i] = dcSpecs[i].build()
+		huffAC[i] = acSpecs[i].build()
+	}
+}
+
+var zigzag = [64]int{
+	0, 1, 8, 16, 9, 2, 3, 10,
+	17, 24, 32, 25, 18, 11, 4, 5,
+	12, 19, 26, 33, 40, 48, 41, 34,
+	27, 20, 13, 6, 7, 14, 21, 28,
+	35, 42, 49, 56, 57, 50, 43, 36,
+	29, 22, 15, 23, 30, 37, 44, 51,
+	58, 59, 52, 45, 38, 31, 39, 46,
+	53, 60, 61, 54, 47, 55, 62, 63,
+}
+
+// baseQuant are the luminance and chrominance tables of Annex K.1, in
+// natural order.
+var baseQuant = [2][64]int32{
+	{
+		16, 11, 10, 16, 24, 40, 51, 61,
+		12, 12, 14, 19, 26, 58, 60, 55,
+		14, 13, 16, 24, 40, 57, 69, 56,
+		14, 17, 22, 29, 51, 87, 80, 62,
+		18, 22, 37, 56, 68, 109, 103, 77,
+		24, 35, 55, 64, 81, 104, 113, 92,
+		49, 64, 78, 87, 103, 121, 120, 101,
+		72, 92, 95, 98, 112, 100, 103, 99,
+	},
+	{
+		17, 18, 24, 47, 99, 99, 99, 99,
+		18, 21, 26, 66, 99, 99, 99, 99,
+		24, 26, 56, 99, 99, 99, 99, 99,
+		47, 66, 99, 99, 99, 99, 99, 99,
+		99, 99, 99, 99, 99, 99, 99, 99,
+		99, 99, 99, 99, 99, 99, 99, 99,
+		99, 99, 99, 99, 99, 99, 99, 99,
+		99, 99, 99, 99, 99, 99, 99, 99,
+	},
+}
+
+// dcSpecs and acSpecs are the Huffman tables of Annex K.3.
+var dcSpecs = [2]huffSpec{
+	{
+		[16]byte{0, 1, 5, 1, 1, 1, 1, 1, 1, 0, 0, 0, 0, 0, 0, 0},
+		[]byte{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11},
+	},
+	{
+		[16]byte{0, 3, 1, 1, 1, 1, 1, 1, 1, 1, 1, 0, 0, 0, 0, 0},
+		[]byte{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11},
+	},
+}
+
+var acSpecs = [2]huffSpec{
+	{
+		[16]byte{0, 2, 1, 3, 3, 2, 4, 3, 5, 5, 4, 4, 0, 0, 1, 0x7d},
+		[]byte{
+			0x01, 0x02, 0x03, 0x00, 0x04, 0x11, 0x05, 0x12,
+			0x21, 0x31, 0x41, 0x06, 0x13, 0x51, 0x61, 0x07,
+			0x22, 0x71, 0x14, 0x32, 0x81, 0x91, 0xa1, 0x08,
+			0x23, 0x42, 0xb1, 0xc1, 0x15, 0x52, 0xd1, 0xf0,
+			0x24, 0x33, 0x62, 0x72, 0x82, 0x09, 0x0a, 0x16,
+			0x17, 0x18, 0x19, 0x1a, 0x25, 0x26, 0x27, 0x28,
+			0x29, 0x2a, 0x34, 0x35, 0x36, 0x37, 0x38, 0x39,
+			0x3a, 0x43, 0x44, 0x45, 0x46, 0x47, 0x48, 0x49,
+			0x4a, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58, 0x59,
+			0x5a, 0x63, 0x64, 0x65, 0x66, 0x67, 0x68, 0x69,
+			0x6a, 0x73, 0x74, 0x75, 0x76, 0x77, 0x78, 0x79,
+			0x7a, 0x83, 0x84, 0x85, 0x86, 0x87, 0x88, 0x89,
+			0x8a, 0x92, 0x93, 0x94, 0x95, 0x96, 0x97, 0x98,
+			0x99, 0x9a, 0xa2, 0xa3, 0xa4, 0xa5, 0xa6, 0xa7,
+			0xa8, 0xa9, 0xaa, 0xb2, 0xb3, 0xb4, 0xb5, 0xb6,
+			0xb7, 0xb8, 0xb9, 0xba, 0xc2, 0xc3, 0xc4, 0xc5,
+			0xc6, 0xc7, 0xc8, 0xc9, 0xca, 0xd2, 0xd3, 0xd4,
+			0xd5, 0xd6, 0xd7, 0xd8, 0xd9, 0xda, 0xe1, 0xe2,
+			0xe3, 0xe4, 0xe5, 0xe6, 0xe7, 0xe8, 0xe9, 0xea,
+			0xf1, 0xf2, 0xf3, 0xf4, 0xf5, 0xf6, 0xf7, 0xf8,
+			0xf9, 0xfa,
+		},
+	},
+	{
+		[16]byte{0, 2, 1, 2, 4, 4, 3, 4, 7, 5, 4, 4, 0, 1, 2, 0x77},
+		[]byte{
+			0x00, 0x01, 0x02, 0x03, 0x11, 0x04, 0x05, 0x21,
+			0x31, 0x06, 0x12, 0x41, 0x51, 0x07, 0x61, 0x71,
+			0x13, 0x22, 0x32, 0x81, 0x08, 0x14, 0x42, 0x91,
+			0xa1, 0xb1, 0xc1, 0x09, 0x23, 0x33, 0x52, 0xf0,
+			0x15, 0x62, 0x72, 0xd1, 0x0a, 0x16, 0x24, 0x34,
+			0xe1, 0x25, 0xf1, 0x17, 0x18, 0x19, 0x1a, 0x26,
+			0x27, 0x28, 0x29, 0x2a, 0x35, 0x36, 0x37, 0x38,
+			0x39, 0x3a, 0x43, 0x44, 0x45, 0x46, 0x47, 0x48,
+			0x49, 0x4a, 0x53, 0x54, 0x55, 0x56, 0x57, 0x58,
+			0x59, 0x5a, 0x63, 0x64, 0x65, 0x66, 0x67, 0x68,
+			0x69, 0x6a, 0x73, 0x74, 0x75, 0x76, 0x77, 0x78,
+			0x79, 0x7a, 0x82, 0x83, 0x84, 0x85, 0x86, 0x87,
+			0x88, 0x89, 0x8a, 0x92, 0x93, 0x94, 0x95, 0x96,
+			0x97, 0x98, 0x99, 0x9a, 0xa2, 0xa3, 0xa4, 0xa5,
+			0xa6, 0xa7, 0xa8, 0xa9, 0xaa, 0xb2, 0xb3, 0xb4,
+			0xb5, 0xb6, 0xb7, 0xb8, 0xb9, 0xba, 0xc2, 0xc3,
+			0xc4, 0xc5, 0xc6, 0xc7, 0xc8, 0xc9, 0xca, 0xd2,
+			0xd3, 0xd4, 0xd5, 0xd6, 0xd7, 0xd8, 0xd9, 0xda,
+			0xe2, 0xe3, 0xe4, 0xe5, 0xe6, 0xe7, 0xe8, 0xe9,
+			0xea, 0xf2, 0xf3, 0xf4, 0xf5, 0xf6, 0xf7, 0xf8,
+			0xf9, 0xfa,
+		},
+	},
+}
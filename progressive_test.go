@@ -0,0 +1,128 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"testing"
+)
+
+// meanError is the mean absolute difference per channel between two
+// images of the same size, ignoring alpha.
+func meanError(a, b image.Image) float64 {
+	na, nb := toNRGBA(a), toNRGBA(b)
+	sum := 0
+	for i := range na.Pix {
+		if i%4 == 3 {
+			continue
+		}
+		d := int(na.Pix[i]) - int(nb.Pix[i])
+		if d < 0 {
+			d = -d
+		}
+		sum += d
+	}
+	return float64(sum) / float64(len(na.Pix)*3/4)
+}
+
+func uniform(w, h int, c color.NRGBA) *image.NRGBA {
+	img := image.NewNRGBA(image.Rect(0, 0, w, h))
+	for i := 0; i < len(img.Pix); i += 4 {
+		img.Pix[i], img.Pix[i+1], img.Pix[i+2], img.Pix[i+3] = c.R, c.G, c.B, c.A
+	}
+	return img
+}
+
+func TestProgressiveJPEGRoundTrip(t *testing.T) {
+	sizes := []image.Point{{1, 1}, {7, 5}, {8, 8}, {17, 9}, {33, 65}, {100, 75}}
+	for _, size := range sizes {
+		for _, q := range []int{10, 85, 100} {
+			src := Flatten(testImage(size.X, size.Y), nil)
+			var buf bytes.Buffer
+			if err := EncodeProgressiveJPEG(&buf, src, q); err != nil {
+				t.Fatalf("%v q%d: %v", size, q, err)
+			}
+			if !bytes.Contains(buf.Bytes(), []byte{0xff, 0xc2}) {
+				t.Fatalf("%v q%d: no progressive SOF2 marker", size, q)
+			}
+			got, err := jpeg.Decode(&buf)
+			if err != nil {
+				t.Fatalf("%v q%d: decode: %v", size, q, err)
+			}
+			if got.Bounds().Size() != size {
+				t.Fatalf("%v q%d: decoded size %v", size, q, got.Bounds().Size())
+			}
+
+			// Within a little of the baseline encoder at the same quality.
+			var base bytes.Buffer
+			jpeg.Encode(&base, src, &jpeg.Options{Quality: q})
+			want, _ := jpeg.Decode(&base)
+			if e, be := meanError(src, got), meanError(src, want); e > be+3 {
+				t.Errorf("%v q%d: mean error %.2f, baseline %.2f", size, q, e, be)
+			}
+		}
+	}
+}
+
+func TestProgressiveJPEGSingleColor(t *testing.T) {
+	for _, size := range []image.Point{{1, 1}, {13, 3}, {64, 64}} {
+		c := color.NRGBA{0x30, 0x90, 0xd0, 0xff}
+		var buf bytes.Buffer
+		if err := EncodeProgressiveJPEG(&buf, uniform(size.X, size.Y, c), 90); err != nil {
+			t.Fatal(err)
+		}
+		got, err := jpeg.Decode(&buf)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if e := meanError(uniform(size.X, size.Y, c), got); e > 2 {
+			t.Errorf("%v: mean error %.2f on a single color", size, e)
+		}
+	}
+}
+
+// TestProgressiveJPEGAlpha goes through Encode, which flattens onto bg
+// for formats without alpha.
+func TestProgressiveJPEGAlpha(t *testing.T) {
+	src := testImage(23, 17)
+	o := &Options{Progressive: true, Quality: 95, Background: color.NRGBA{0, 0, 0x40, 0xff}}
+	var buf bytes.Buffer
+	if err := Encode(&buf, src, formats["jpg"], o); err != nil {
+		t.Fatal(err)
+	}
+	got, err := jpeg.Decode(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e := meanError(Flatten(src, o.Background), got); e > 4 {
+		t.Errorf("mean error %.2f against the flattened source", e)
+	}
+}
+
+// shortWriter fails once more than n bytes have been written.
+type shortWriter struct{ n int }
+
+func (w *shortWriter) Write(p []byte) (int, error) {
+	if len(p) > w.n {
+		k := w.n
+		w.n = 0
+		return k, errors.New("disk full")
+	}
+	w.n -= len(p)
+	return len(p), nil
+}
+
+func TestProgressiveJPEGWriteError(t *testing.T) {
+	src := Flatten(testImage(300, 200), nil)
+	var buf bytes.Buffer
+	if err := EncodeProgressiveJPEG(&buf, src, 90); err != nil {
+		t.Fatal(err)
+	}
+	for _, n := range []int{0, 100, buf.Len() / 2, buf.Len() - 1} {
+		if err := EncodeProgressiveJPEG(&shortWriter{n}, src, 90); err == nil {
+			t.Errorf("writer failing after %d of %d bytes: got no error", n, buf.Len())
+		}
+	}
+}
@@ -0,0 +1,131 @@
+package main
+
+import (
+	"image"
+	"image/color"
+)
+
+// Quantize reduces img to a palette of at most n colors, chosen like
+// Palette chooses them. Pixels less than half opaque share one
+// transparent entry, which takes a slot only when there are such pixels;
+// the others become opaque. With dither the rounding error of each pixel
+// is spread onto its neighbours, Floyd–Steinberg style.
+func Quantize(img image.Image, n int, dither bool) *image.Paletted {
+	src := toNRGBA(img)
+	return remap(src, quantizePalette([]*image.NRGBA{src}, n), dither)
+}
+
+// quantizePalette chooses one palette of at most n colors for all of
+// imgs, from an even sample of their pixels. It ends with a transparent
+// entry when some pixel is less than half opaque.
+func quantizePalette(imgs []*image.NRGBA, n int) color.Palette {
+	total := 0
+	for _, img := range imgs {
+		total += len(img.Pix) / 4
+	}
+	step := (total + paletteSample*paletteSample - 1) / (paletteSample * paletteSample)
+	if step < 1 {
+		step = 1
+	}
+	seeThrough := false
+	var pixels [][3]uint8
+	for _, img := range imgs {
+		for i := 0; i < len(img.Pix); i += 4 {
+			if img.Pix[i+3] < 0x80 {
+				seeThrough = true
+			} else if i/4%step == 0 {
+				pixels = append(pixels, [3]uint8{img.Pix[i], img.Pix[i+1], img.Pix[i+2]})
+			}
+		}
+	}
+	if seeThrough {
+		n--
+	}
+	var palette color.Palette
+	for _, s := range swatches(pixels, n) {
+		palette = append(palette, s.Color)
+	}
+	if seeThrough || len(palette) == 0 {
+		palette = append(palette, color.NRGBA{})
+	}
+	return palette
+}
+
+// remap draws src with the colors of palette, whose transparent entry,
+// if any, takes the pixels less than half opaque.
+func remap(src *image.NRGBA, palette color.Palette, dither bool) *image.Paletted {
+	transparent := transparentIndex(palette)
+	dst := image.NewPaletted(src.Rect, palette)
+	nearest := newNearest(palette, transparent)
+	w, h := src.Rect.Dx(), src.Rect.Dy()
+	// errors of the current and the next row, one pixel of margin on
+	// either side.
+	cur, next := make([][3]float64, w+2), make([][3]float64, w+2)
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			i := src.PixOffset(src.Rect.Min.X+x, src.Rect.Min.Y+y)
+			o := dst.PixOffset(dst.Rect.Min.X+x, dst.Rect.Min.Y+y)
+			if src.Pix[i+3] < 0x80 {
+				dst.Pix[o] = uint8(transparent)
+				continue
+			}
+			var v [3]float64
+			for c := 0; c < 3; c++ {
+				v[c] = float64(src.Pix[i+c])
+				if dither {
+					v[c] = clamp(v[c]+cur[x+1][c], 0, 255)
+				}
+			}
+			k := nearest.index(uint8(v[0]+0.5), uint8(v[1]+0.5), uint8(v[2]+0.5))
+			dst.Pix[o] = uint8(k)
+			if !dither {
+				continue
+			}
+			p := palette[k].(color.NRGBA)
+			for c, q := range [3]uint8{p.R, p.G, p.B} {
+				e := v[c] - float64(q)
+				cur[x+2][c] += e * 7 / 16
+				next[x][c] += e * 3 / 16
+				next[x+1][c] += e * 5 / 16
+				next[x+2][c] += e * 1 / 16
+			}
+		}
+		cur, next = next, cur
+		for i := range next {
+			next[i] = [3]float64{}
+		}
+	}
+	return dst
+}
+
+// nearest finds the closest opaque palette entry, remembering colors it
+// has already looked up.
+type nearest struct {
+	palette     color.Palette
+	transparent int
+	cache       map[uint32]int
+}
+
+func newNearest(palette color.Palette, transparent int) *nearest {
+	return &nearest{palette, transparent, map[uint32]int{}}
+}
+
+func (n *nearest) index(r, g, b uint8) int {
+	key := uint32(r)<<16 | uint32(g)<<8 | uint32(b)
+	if k, ok := n.cache[key]; ok {
+		return k
+	}
+	best, dist := n.transparent, -1
+	for k, c := range n.palette {
+		if k == n.transparent {
+			continue
+		}
+		p := c.(color.NRGBA)
+		dr, dg, db := int(r)-int(p.R), int(g)-int(p.G), int(b)-int(p.B)
+		if d := dr*dr + dg*dg + db*db; dist < 0 || d < dist {
+			best, dist = k, d
+		}
+	}
+	n.cache[key] = best
+	return best
+}
@@ -0,0 +1,176 @@
+package main
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/gif"
+	"image/png"
+	"strconv"
+	"testing"
+)
+
+func TestQuantizeColors(t *testing.T) {
+	images := map[string]image.Image{
+		"opaque": Flatten(testImage(64, 48), nil),
+		"alpha":  testImage(64, 48),
+		"noise":  noisy(64, 48),
+		"single": uniform(9, 7, red),
+		"clear":  uniform(9, 7, color.NRGBA{}),
+	}
+	for name, img := range images {
+		for _, n := range []int{2, 3, 16, 255, 256} {
+			for _, dither := range []bool{false, true} {
+				p := Quantize(img, n, dither)
+				if len(p.Palette) > n {
+					t.Errorf("%s, %d colors, dither %v: %d palette entries", name, n, dither, len(p.Palette))
+				}
+				if p.Rect.Size() != img.Bounds().Size() {
+					t.Errorf("%s: size %v", name, p.Rect.Size())
+				}
+				for _, i := range p.Pix {
+					if int(i) >= len(p.Palette) {
+						t.Fatalf("%s, %d colors: index %d past the palette", name, n, i)
+					}
+				}
+			}
+		}
+	}
+}
+
+// TestQuantizeExact checks an image with no more colors than asked for
+// comes out unchanged, dithered or not.
+func TestQuantizeExact(t *testing.T) {
+	img := image.NewNRGBA(image.Rect(0, 0, 12, 8))
+	for y := 0; y < 8; y++ {
+		for x := 0; x < 12; x++ {
+			c := []color.NRGBA{red, green, blue, {}}[(x/3+y/4)%4]
+			img.SetNRGBA(x, y, c)
+		}
+	}
+	for _, dither := range []bool{false, true} {
+		p := Quantize(img, 4, dither)
+		for y := 0; y < 8; y++ {
+			for x := 0; x < 12; x++ {
+				want := img.NRGBAAt(x, y)
+				got := color.NRGBAModel.Convert(p.At(x, y)).(color.NRGBA)
+				if got != want && !(got.A == 0 && want.A == 0) {
+					t.Fatalf("dither %v: (%d,%d) is %v, want %v", dither, x, y, got, want)
+				}
+			}
+		}
+	}
+}
+
+// TestQuantizeDither checks dithering keeps the average color of a
+// gradient that two colors cannot show.
+func TestQuantizeDither(t *testing.T) {
+	img := uniform(32, 32, color.NRGBA{0x80, 0x80, 0x80, 0xff})
+	img.SetNRGBA(0, 0, color.NRGBA{0, 0, 0, 0xff})
+	img.SetNRGBA(31, 31, color.NRGBA{0xff, 0xff, 0xff, 0xff})
+	mean := func(p *image.Paletted) float64 {
+		sum := 0
+		for _, i := range p.Pix {
+			sum += int(p.Palette[i].(color.NRGBA).R)
+		}
+		return float64(sum) / float64(len(p.Pix))
+	}
+	palette := color.Palette{color.NRGBA{0, 0, 0, 0xff}, color.NRGBA{0xff, 0xff, 0xff, 0xff}}
+	if m := mean(remap(img, palette, true)); m < 0x70 || m > 0x90 {
+		t.Errorf("dithered mean %.1f, want about 128", m)
+	}
+	if m := mean(remap(img, palette, false)); m > 0x10 && m < 0xf0 {
+		t.Errorf("undithered mean %.1f, want near black or white", m)
+	}
+}
+
+func TestColorsPNG8(t *testing.T) {
+	dir := t.TempDir()
+	writePNG(t, dir, "a.png", noisy(50, 40))
+	h := &Handler{Engine: &Engine{Config: DefaultConfig, Origin: Dir(dir)}}
+	for _, n := range []int{2, 16, 256} {
+		for _, query := range []string{"colors=" + strconv.Itoa(n), "colors=" + strconv.Itoa(n) + "&dither=true"} {
+			rec := get(t, h, "/a.png?"+query)
+			if rec.Code != 200 {
+				t.Fatalf("%s: status %d: %s", query, rec.Code, rec.Body)
+			}
+			img, err := png.Decode(bytes.NewReader(rec.Body.Bytes()))
+			if err != nil {
+				t.Fatal(err)
+			}
+			p, ok := img.(*image.Paletted)
+			if !ok {
+				t.Fatalf("%s: decoded a %T, want a paletted png", query, img)
+			}
+			if len(p.Palette) > n {
+				t.Errorf("%s: %d palette entries", query, len(p.Palette))
+			}
+		}
+	}
+	for _, query := range []string{"colors=1", "colors=257", "colors=4&lossless=true", "colors=4&format=jpg"} {
+		if rec := get(t, h, "/a.png?"+query); rec.Code != 400 {
+			t.Errorf("%s: status %d, want 400", query, rec.Code)
+		}
+	}
+}
+
+// TestGIFStill checks still gif output keeps the colors and the
+// transparency of the image at any quality.
+func TestGIFStill(t *testing.T) {
+	dir := t.TempDir()
+	orange, blue := color.NRGBA{255, 200, 0, 255}, color.NRGBA{30, 60, 200, 255}
+	src := image.NewNRGBA(image.Rect(0, 0, 40, 40))
+	for y := 0; y < 40; y++ {
+		for x := 0; x < 40; x++ {
+			c := orange
+			if x >= 20 {
+				c = blue
+			}
+			src.SetNRGBA(x, y, c)
+		}
+	}
+	writePNG(t, dir, "a.png", src)
+	writePNG(t, dir, "photo.png", Flatten(testImage(60, 40), nil))
+	h := &Handler{Engine: &Engine{Config: DefaultConfig, Origin: Dir(dir)}}
+	decode := func(query string) image.Image {
+		t.Helper()
+		rec := get(t, h, query)
+		if rec.Code != 200 {
+			t.Fatalf("%s: status %d: %s", query, rec.Code, rec.Body)
+		}
+		img, err := gif.Decode(bytes.NewReader(rec.Body.Bytes()))
+		if err != nil {
+			t.Fatalf("%s: %v", query, err)
+		}
+		return img
+	}
+
+	for _, q := range []string{"1", "10", "50", "100"} {
+		query := "/a.png?format=gif&q=" + q
+		img := decode(query)
+		for _, tt := range []struct {
+			x, y int
+			want color.NRGBA
+		}{{5, 20, orange}, {35, 20, blue}} {
+			if c := color.NRGBAModel.Convert(img.At(tt.x, tt.y)).(color.NRGBA); c != tt.want {
+				t.Errorf("%s: (%d,%d) is %v, want %v", query, tt.x, tt.y, c, tt.want)
+			}
+		}
+	}
+
+	img := decode("/a.png?format=gif&mask=circle")
+	if _, _, _, a := img.At(0, 0).RGBA(); a != 0 {
+		t.Errorf("mask=circle: corner is %v, want transparent", img.At(0, 0))
+	}
+	if c := color.NRGBAModel.Convert(img.At(10, 20)).(color.NRGBA); c != orange {
+		t.Errorf("mask=circle: (10,20) is %v, want %v", c, orange)
+	}
+
+	photo := Flatten(testImage(60, 40), nil)
+	if e := meanError(photo, decode("/photo.png?format=gif")); e > 5 {
+		t.Errorf("format=gif: mean error %.2f", e)
+	}
+	if e := meanError(photo, decode("/photo.png?format=gif&q=5")); e > 20 {
+		t.Errorf("format=gif&q=5: mean error %.2f", e)
+	}
+}
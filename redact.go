@@ -0,0 +1,41 @@
+package main
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+)
+
+// Redact hides every redact(...) region according to redact-mode.
+// Regions are in the pixels of img.
+func Redact(img image.Image, o *Options) image.Image {
+	if len(o.Redact) == 0 {
+		return img
+	}
+	dst := toRGBA(img)
+	for _, c := range o.Redact {
+		r := c.Rect(dst.Rect)
+		if r.Empty() {
+			continue
+		}
+		sub := dst.SubImage(r).(*image.RGBA)
+		size := r.Dx()
+		if r.Dy() > size {
+			size = r.Dy()
+		}
+
+		switch o.RedactMode {
+		case "blur":
+			blurRGBA(sub, float64(size)/4)
+		case "pixelate":
+			block := size / 8
+			if block < 4 {
+				block = 4
+			}
+			pixelateRGBA(sub, block)
+		default:
+			draw.Draw(sub, r, image.NewUniform(color.Black), image.Point{}, draw.Src)
+		}
+	}
+	return dst
+}
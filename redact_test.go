@@ -0,0 +1,98 @@
+package main
+
+import (
+	"image"
+	"image/color"
+	"testing"
+)
+
+func TestRedact(t *testing.T) {
+	black, white := color.NRGBA{0, 0, 0, 255}, color.NRGBA{255, 255, 255, 255}
+	src := checker(64, 48, 1, black, white)
+	region := image.Rect(8, 8, 40, 40)
+	tests := []struct {
+		mode string
+		// hidden reports whether the redacted region no longer shows
+		// the checkerboard.
+		hidden func(t *testing.T, out *image.NRGBA)
+	}{
+		{"fill", func(t *testing.T, out *image.NRGBA) {
+			for y := region.Min.Y; y < region.Max.Y; y++ {
+				for x := region.Min.X; x < region.Max.X; x++ {
+					if c := out.NRGBAAt(x, y); c != black {
+						t.Fatalf("(%d,%d) is %v, want black", x, y, c)
+					}
+				}
+			}
+		}},
+		{"blur", func(t *testing.T, out *image.NRGBA) {
+			inner := region.Inset(2)
+			_, before := stats(src.SubImage(inner))
+			if _, v := stats(out.SubImage(inner)); v > before/100 {
+				t.Errorf("variance %.1f inside the region, want below 1%% of %.0f", v, before)
+			}
+		}},
+		{"pixelate", func(t *testing.T, out *image.NRGBA) {
+			// 32 pixels wide gives blocks of 4, aligned to the region.
+			for y := region.Min.Y; y < region.Max.Y; y++ {
+				for x := region.Min.X; x < region.Max.X; x++ {
+					first := out.NRGBAAt(x-(x-region.Min.X)%4, y-(y-region.Min.Y)%4)
+					if c := out.NRGBAAt(x, y); c != first {
+						t.Fatalf("(%d,%d) is %v, want its block's %v", x, y, c, first)
+					}
+				}
+			}
+			if c := out.NRGBAAt(region.Min.X, region.Min.Y); c.R < 120 || c.R > 135 {
+				t.Errorf("block color %v, want mid gray", c)
+			}
+		}},
+	}
+	for _, tt := range tests {
+		o := &Options{RedactMode: tt.mode, Redact: []*CropOption{{X: 8, Y: 8, Width: 32, Height: 32}}}
+		out := toNRGBA(Redact(src, o))
+		tt.hidden(t, out)
+		for y := 0; y < 48; y++ {
+			for x := 0; x < 64; x++ {
+				if image.Pt(x, y).In(region) {
+					continue
+				}
+				if c, want := out.NRGBAAt(x, y), src.NRGBAAt(x, y); c != want {
+					t.Fatalf("%s: (%d,%d) outside the region is %v, want %v", tt.mode, x, y, c, want)
+				}
+			}
+		}
+	}
+}
+
+func TestRedactClips(t *testing.T) {
+	src := uniform(20, 20, color.NRGBA{255, 255, 255, 255})
+	o := &Options{RedactMode: "fill", Redact: []*CropOption{
+		{X: 15, Y: 15, Width: 50, Height: 50},
+		{X: 40, Y: 40, Width: 5, Height: 5},
+		{X: 0, Y: 0, Width: 2},
+	}}
+	out := toNRGBA(Redact(src, o))
+	for _, p := range []image.Point{{19, 19}, {15, 15}, {0, 19}, {1, 0}} {
+		if c := out.NRGBAAt(p.X, p.Y); c.R != 0 {
+			t.Errorf("%v is %v, want black", p, c)
+		}
+	}
+	for _, p := range []image.Point{{14, 14}, {2, 0}} {
+		if c := out.NRGBAAt(p.X, p.Y); c.R != 255 {
+			t.Errorf("%v is %v, want white", p, c)
+		}
+	}
+}
+
+func TestRedactOptions(t *testing.T) {
+	o, err := parseOptions("?redact(x8,y8,w32,h32)&redact(x0,y0,w4,h4)&redact-mode=pixelate")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(o.Redact) != 2 || o.RedactMode != "pixelate" {
+		t.Fatalf("got %d regions in mode %q, want 2 in pixelate", len(o.Redact), o.RedactMode)
+	}
+	if r := *o.Redact[0]; r != (CropOption{8, 8, 32, 32}) {
+		t.Errorf("first region %+v", r)
+	}
+}
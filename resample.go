@@ -0,0 +1,50 @@
+package main
+
+import (
+	"image"
+	"math"
+
+	"golang.org/x/image/draw"
+)
+
+var filters = map[string]draw.Interpolator{
+	"nearest":  draw.NearestNeighbor,
+	"bilinear": draw.BiLinear,
+	"bicubic":  draw.CatmullRom,
+	"lanczos":  &draw.Kernel{Support: 3, At: lanczos3},
+}
+
+// Resize resamples img to size with the named filter, one of nearest,
+// bilinear, bicubic or lanczos. An unknown name falls back to bicubic.
+func Resize(img image.Image, size image.Point, filter string) image.Image {
+	b := img.Bounds()
+	if b.Size() == size {
+		return img
+	}
+	interp, ok := filters[filter]
+	if !ok {
+		interp = draw.CatmullRom
+	}
+	dst := image.NewNRGBA(image.Rect(0, 0, size.X, size.Y))
+	interp.Scale(dst, dst.Rect, img, b, draw.Src, nil)
+	return dst
+}
+
+// ResizeFilter returns the filter for o, or the server default.
+func (c Config) ResizeFilter(o *Options) string {
+	if o.Filter != "" {
+		return o.Filter
+	}
+	return c.Filter
+}
+
+func lanczos3(t float64) float64 {
+	if t == 0 {
+		return 1
+	}
+	if t <= -3 || t >= 3 {
+		return 0
+	}
+	pt := math.Pi * t
+	return 3 * math.Sin(pt) * math.Sin(pt/3) / (pt * pt)
+}
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"bytes"
+	"net/http"
+	"os"
+	"strconv"
+)
+
+// Handler serves images through an Engine: the path names the source on
+// Engine.Origin and the query holds the parameters.
+type Handler struct {
+	Engine *Engine
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	p := &Peg{}
+	p.Buffer = "?" + r.URL.RawQuery
+	p.Init()
+	p.Params = map[string]interface{}{}
+	p.TupleParams = map[string]interface{}{}
+	if err := p.Parse(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	p.Execute()
+	o, err := p.Options()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	src, err := Load(h.Engine.Origin, r.URL.Path)
+	if os.IsNotExist(err) {
+		http.NotFound(w, r)
+		return
+	} else if err != nil {
+		http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+		return
+	}
+
+	if o.Format == "auto" {
+		o.Format = AutoFormat(r.Header.Get("Accept"), src, o).Name
+		w.Header().Add("Vary", "Accept")
+	}
+	var buf bytes.Buffer
+	f, err := h.Engine.Render(&buf, src, o)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	w.Header().Set("Content-Type", f.MediaType)
+	if o.MaxBytes > 0 && f.Quality {
+		w.Header().Set("X-Image-Quality", strconv.Itoa(o.Quality))
+	}
+	w.Write(buf.Bytes())
+}
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"image"
+	"image/color"
+)
+
+// Stylize applies mono, sepia, duotone and invert, in that order.
+func Stylize(img image.Image, o *Options) image.Image {
+	if !o.Mono && o.Sepia == 0 && len(o.Duotone) != 2 && !o.Invert {
+		return img
+	}
+	dst := toNRGBA(img)
+
+	var dark, light color.NRGBA
+	if len(o.Duotone) == 2 {
+		dark = color.NRGBAModel.Convert(o.Duotone[0]).(color.NRGBA)
+		light = color.NRGBAModel.Convert(o.Duotone[1]).(color.NRGBA)
+	}
+	sepia := float64(o.Sepia) / 100
+
+	for i := 0; i < len(dst.Pix); i += 4 {
+		r, g, b := float64(dst.Pix[i]), float64(dst.Pix[i+1]), float64(dst.Pix[i+2])
+		if o.Mono {
+			y := luma(r, g, b)
+			r, g, b = y, y, y
+		}
+		if sepia > 0 {
+			sr := 0.393*r + 0.769*g + 0.189*b
+			sg := 0.349*r + 0.686*g + 0.168*b
+			sb := 0.272*r + 0.534*g + 0.131*b
+			r, g, b = r+(sr-r)*sepia, g+(sg-g)*sepia, b+(sb-b)*sepia
+		}
+		if len(o.Duotone) == 2 {
+			t := clamp(luma(r, g, b), 0, 255) / 255
+			r = float64(dark.R) + (float64(light.R)-float64(dark.R))*t
+			g = float64(dark.G) + (float64(light.G)-float64(dark.G))*t
+			b = float64(dark.B) + (float64(light.B)-float64(dark.B))*t
+		}
+		r, g, b = clamp(r, 0, 255), clamp(g, 0, 255), clamp(b, 0, 255)
+		if o.Invert {
+			r, g, b = 255-r, 255-g, 255-b
+		}
+		dst.Pix[i], dst.Pix[i+1], dst.Pix[i+2] = uint8(r+0.5), uint8(g+0.5), uint8(b+0.5)
+	}
+	return dst
+}
+
+// luma uses the same weights as color.GrayModel.
+func luma(r, g, b float64) float64 {
+	return 0.299*r + 0.587*g + 0.114*b
+}
@@ -0,0 +1,44 @@
+package main
+
+import (
+	"image/color"
+	"testing"
+)
+
+func TestStylize(t *testing.T) {
+	src := testImage(16, 12)
+	tests := []struct {
+		name string
+		o    Options
+	}{
+		{"mono", Options{Mono: true}},
+		{"sepia", Options{Sepia: 80}},
+		{"invert", Options{Invert: true}},
+		{"duotone", Options{Duotone: []color.Color{color.NRGBA{0x20, 0x10, 0x60, 0xff}, color.NRGBA{0xff, 0xd0, 0x40, 0xff}}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checkGolden(t, "stylize-"+tt.name, Stylize(src, &tt.o))
+		})
+	}
+}
+
+func TestStylizeKeepsAlpha(t *testing.T) {
+	src := testImage(16, 12)
+	got := toNRGBA(Stylize(src, &Options{Mono: true, Invert: true}))
+	for i := 0; i < len(got.Pix); i += 4 {
+		if got.Pix[i+3] != src.Pix[i+3] {
+			t.Fatalf("alpha at %d is %d, want %d", i/4, got.Pix[i+3], src.Pix[i+3])
+		}
+		if got.Pix[i] != got.Pix[i+1] || got.Pix[i+1] != got.Pix[i+2] {
+			t.Fatalf("mono pixel %d is %v, want gray", i/4, got.Pix[i:i+3])
+		}
+	}
+}
+
+func TestStylizeNoop(t *testing.T) {
+	src := testImage(4, 4)
+	if got := Stylize(src, &Options{}); got != src {
+		t.Error("Stylize without options copied the image")
+	}
+}
@@ -0,0 +1,70 @@
+package main
+
+import (
+	"image"
+
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/gofont/gobold"
+	"golang.org/x/image/font/gofont/goitalic"
+	"golang.org/x/image/font/gofont/gomono"
+	"golang.org/x/image/font/gofont/goregular"
+	"golang.org/x/image/font/opentype"
+	"golang.org/x/image/math/fixed"
+)
+
+// Fonts is the registry txt-font selects from. It holds TTF data so the
+// server needs no font files on disk; RegisterFont adds more.
+var Fonts = map[string][]byte{
+	"regular": goregular.TTF,
+	"bold":    gobold.TTF,
+	"italic":  goitalic.TTF,
+	"mono":    gomono.TTF,
+}
+
+// RegisterFont makes ttf available as txt-font=name.
+func RegisterFont(name string, ttf []byte) error {
+	if _, err := opentype.Parse(ttf); err != nil {
+		return err
+	}
+	Fonts[name] = ttf
+	return nil
+}
+
+// Caption draws txt onto img. txt-size and txt-pad are multiplied by
+// factor.
+func Caption(img image.Image, o *Options, factor float64) (image.Image, error) {
+	if o.Text == "" {
+		return img, nil
+	}
+	f, err := opentype.Parse(Fonts[o.TextFont])
+	if err != nil {
+		return nil, err
+	}
+	face, err := opentype.NewFace(f, &opentype.FaceOptions{
+		Size:    float64(o.TextSize) * factor,
+		DPI:     72,
+		Hinting: font.HintingFull,
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer face.Close()
+
+	dst := toNRGBA(img)
+	metrics := face.Metrics()
+	size := image.Pt(
+		font.MeasureString(face, o.Text).Ceil(),
+		(metrics.Ascent + metrics.Descent).Ceil(),
+	)
+	pad := round(float64(o.TextPad) * factor)
+	at := alignIn(dst.Rect.Inset(pad), size, o.TextAlign)
+
+	d := &font.Drawer{
+		Dst:  dst,
+		Src:  image.NewUniform(o.TextColor),
+		Face: face,
+		Dot:  fixed.P(at.X, at.Y).Add(fixed.Point26_6{Y: metrics.Ascent}),
+	}
+	d.DrawString(o.Text)
+	return dst, nil
+}
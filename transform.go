@@ -0,0 +1,193 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/draw"
+	"image/gif"
+	"io"
+)
+
+// Engine executes Options on images.
+type Engine struct {
+	Config Config
+	Origin Origin // where overlays and mask images are fetched from
+}
+
+// Transform runs the pipeline on img. The stages always run in this
+// order, each skipped when its parameters are absent:
+//
+//  1. redact               hide regions, in source pixels
+//  2. trim                 cut uniform borders
+//  3. crop                 Layout.Crop, from crop(...) and fit=crop
+//  4. fit/width/height     resample to Layout.Size with filter
+//  5. reverse              flip (upside down) or flop (mirrored)
+//  6. bri/con/gam/sat/hue  Adjust
+//  7. mono/sepia/...       Stylize
+//  8. blur/sharp/px        Filter
+//  9. pad/border/bg        Frame onto Layout.Canvas
+//  10. mark, txt           Watermark, Caption
+//  11. corner-radius/mask  Mask
+//
+// So redact regions are in source pixels and no output can show them;
+// crop(...) is relative to the trimmed image; the color stages cost what
+// the output size costs; mark-w, mark-pad and the mask follow the final
+// canvas. Encode flattens onto bg when the output format has no alpha.
+//
+// The result has exactly the size Layout reports for the trimmed source.
+func (e *Engine) Transform(img image.Image, o *Options) (image.Image, error) {
+	img, l, err := e.Geometry(img, o)
+	if err != nil {
+		return nil, err
+	}
+	img = Reverse(img, o.Reverse)
+
+	img = Adjust(img, o)
+	img = Stylize(img, o)
+	img = Filter(img, o)
+	img = Frame(img, l, o)
+
+	filter := e.Config.ResizeFilter(o)
+	if img, err = Watermark(img, e.Origin, o, l.Factor, filter); err != nil {
+		return nil, err
+	}
+	if img, err = Caption(img, o, l.Factor); err != nil {
+		return nil, err
+	}
+	return Mask(img, e.Origin, o, l.Factor, filter)
+}
+
+// Geometry runs the stages up to fit, 1 to 4 of Transform, and returns
+// the result with the Layout it followed.
+func (e *Engine) Geometry(img image.Image, o *Options) (image.Image, Layout, error) {
+	img = Redact(img, o)
+	img = Trim(img, o)
+
+	l, err := o.Layout(img.Bounds().Size(), e.Config)
+	if err != nil {
+		return nil, l, err
+	}
+	img = cropImage(img, l.Crop.Add(img.Bounds().Min))
+	return Resize(img, l.Size, e.Config.ResizeFilter(o)), l, nil
+}
+
+// Render transforms src and writes it to w in the output format, which
+// it returns; format=json writes the Metadata, palette the colors, lqip
+// a placeholder and phash a perceptual hash of the result instead. EXIF
+// is stripped unless exif=true. Then the source EXIF is carried over with
+// orientation and dimensions fixed, minus location tags when
+// strip-gps=true or the server strips them anyway.
+//
+// Render resolves o in place: format=auto is decided without an Accept
+// header (Handler passes the request's) and max-bytes leaves the quality
+// it settled on in o.Quality.
+//
+// frame=N renders one frame of an animation as a still. Otherwise gif
+// output of an animated source stays animated, through TransformGIF;
+// max-bytes is an error there.
+func (e *Engine) Render(w io.Writer, src *Source, o *Options) (*Format, error) {
+	if o.Frame > 0 {
+		var err error
+		if src, err = src.Still(o.Frame); err != nil {
+			return nil, err
+		}
+	}
+	if o.Palette != "" {
+		return e.renderPalette(w, src, o)
+	}
+	if o.Lqip != "" {
+		return e.renderLqip(w, src, o)
+	}
+	if o.PHash != "" {
+		return e.renderPHash(w, src, o)
+	}
+	if o.Format == "auto" {
+		o.Format = AutoFormat("", src, o).Name
+	}
+	f, err := OutputFormat(o, src.Format)
+	if err != nil {
+		return nil, err
+	}
+	if f.Name == "json" {
+		m, err := e.Metadata(src, o)
+		if err != nil {
+			return nil, err
+		}
+		return f, WriteJSON(w, m)
+	}
+	if src.Anim != nil && f.Name == "gif" {
+		if o.MaxBytes > 0 {
+			return nil, fmt.Errorf("max-bytes: not supported for animated output, pick a still with frame")
+		}
+		g, err := e.TransformGIF(src.Anim, o)
+		if err != nil {
+			return nil, err
+		}
+		return f, gif.EncodeAll(w, g)
+	}
+	img, err := e.Transform(src.Image, o)
+	if err != nil {
+		return nil, err
+	}
+	encode := func(img image.Image, o *Options) ([]byte, error) {
+		var buf bytes.Buffer
+		if err := Encode(&buf, img, f, o); err != nil {
+			return nil, err
+		}
+		data := buf.Bytes()
+		if o.Exif && src.Exif != nil {
+			raw := src.Exif.Encode(img.Bounds().Size(), e.Config.StripGPS || o.StripGPS)
+			return embedExif(data, f, raw)
+		}
+		return data, nil
+	}
+	var data []byte
+	if o.MaxBytes > 0 {
+		data, err = fitBudget(img, o, f, e.Config.ResizeFilter(o), encode)
+	} else {
+		data, err = encode(img, o)
+	}
+	if err != nil {
+		return nil, err
+	}
+	_, err = w.Write(data)
+	return f, err
+}
+
+// Reverse mirrors img: flip turns it upside down, flop left to right.
+func Reverse(img image.Image, reverse string) image.Image {
+	if reverse != "flip" && reverse != "flop" {
+		return img
+	}
+	src := toNRGBA(img)
+	dst := image.NewNRGBA(src.Rect)
+	w, h := src.Rect.Dx(), src.Rect.Dy()
+	for y := 0; y < h; y++ {
+		row := src.Pix[y*src.Stride : y*src.Stride+w*4]
+		if reverse == "flip" {
+			copy(dst.Pix[(h-1-y)*dst.Stride:], row)
+			continue
+		}
+		out := dst.Pix[y*dst.Stride:]
+		for x := 0; x < w; x++ {
+			copy(out[(w-1-x)*4:(w-x)*4], row[x*4:x*4+4])
+		}
+	}
+	return dst
+}
+
+// cropImage returns the r part of img, sharing pixels when it can.
+func cropImage(img image.Image, r image.Rectangle) image.Image {
+	if r == img.Bounds() {
+		return img
+	}
+	if s, ok := img.(interface {
+		SubImage(image.Rectangle) image.Image
+	}); ok {
+		return s.SubImage(r)
+	}
+	dst := image.NewNRGBA(image.Rect(0, 0, r.Dx(), r.Dy()))
+	draw.Draw(dst, dst.Rect, img, r.Min, draw.Src)
+	return dst
+}
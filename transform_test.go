@@ -0,0 +1,62 @@
+package main
+
+import (
+	"image"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestTransformFit(t *testing.T) {
+	e := &Engine{Config: DefaultConfig}
+	tests := []struct {
+		query string
+		name  string // golden image, if any
+		size  image.Point
+	}{
+		{"?w=24&h=24&fit=clip", "fit-clip", image.Pt(24, 18)},
+		{"?w=24&h=24&fit=scale", "fit-scale", image.Pt(24, 24)},
+		{"?w=24&h=24&fit=max", "fit-max", image.Pt(24, 18)},
+		{"?w=24&h=24&fit=crop", "fit-crop", image.Pt(24, 24)},
+		{"?w=80&h=80&fit=clip", "", image.Pt(80, 60)},
+		{"?w=80&h=80&fit=max", "", image.Pt(40, 30)},
+		{"?w=80&h=20&fit=crop", "", image.Pt(80, 20)},
+	}
+	for _, tt := range tests {
+		o, err := parseOptions(tt.query)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.query, err)
+		}
+		img, err := e.Transform(testImage(40, 30), o)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.query, err)
+		}
+		if size := img.Bounds().Size(); size != tt.size {
+			t.Errorf("%s: size %v, want %v", tt.query, size, tt.size)
+		}
+		if tt.name != "" {
+			checkGolden(t, tt.name, img)
+		}
+	}
+}
+
+func TestCropOutside(t *testing.T) {
+	e := &Engine{Config: DefaultConfig}
+	o, err := parseOptions("?crop(x1000,y1000,w10,h10)")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := e.Transform(testImage(400, 300), o); err == nil {
+		t.Error("Transform: got no error for a crop outside the source")
+	}
+
+	dir := t.TempDir()
+	writePNG(t, dir, "a.png", testImage(400, 300))
+	h := &Handler{Engine: &Engine{Config: DefaultConfig, Origin: Dir(dir)}}
+	for _, query := range []string{"crop(x1000,y1000,w10,h10)", "crop(x1000,y1000,w10,h10)&format=json", "crop(x1000,y1000,w10,h10)&phash=d"} {
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest("GET", "/a.png?"+query, nil))
+		if rec.Code != 400 {
+			t.Errorf("%s: status %d, want 400", query, rec.Code)
+		}
+	}
+}
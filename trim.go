@@ -0,0 +1,81 @@
+package main
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+)
+
+// Trim cuts uniform borders off img.
+//
+// trim=auto takes the border color from the top left pixel, trim=color
+// uses trim-color. trim-tol is the allowed difference per channel, in
+// percent.
+func Trim(img image.Image, o *Options) image.Image {
+	if o.Trim == "" {
+		return img
+	}
+	r := TrimRect(img, o)
+	if r.Empty() {
+		return img
+	}
+	dst := image.NewNRGBA(image.Rect(0, 0, r.Dx(), r.Dy()))
+	draw.Draw(dst, dst.Rect, img, r.Min, draw.Src)
+	return dst
+}
+
+// TrimRect returns the part of img inside its uniform borders.
+func TrimRect(img image.Image, o *Options) image.Rectangle {
+	b := img.Bounds()
+	if b.Empty() {
+		return b
+	}
+	border := o.TrimColor
+	if o.Trim == "auto" {
+		border = img.At(b.Min.X, b.Min.Y)
+	}
+	tol := uint32(o.TrimTol) * 0xffff / 100
+	br, bg, bb, ba := border.RGBA()
+	same := func(c color.Color) bool {
+		r, g, b, a := c.RGBA()
+		return diff(r, br) <= tol && diff(g, bg) <= tol && diff(b, bb) <= tol && diff(a, ba) <= tol
+	}
+	row := func(y int) bool {
+		for x := b.Min.X; x < b.Max.X; x++ {
+			if !same(img.At(x, y)) {
+				return false
+			}
+		}
+		return true
+	}
+	col := func(x, y0, y1 int) bool {
+		for y := y0; y < y1; y++ {
+			if !same(img.At(x, y)) {
+				return false
+			}
+		}
+		return true
+	}
+
+	r := b
+	for r.Min.Y < r.Max.Y && row(r.Min.Y) {
+		r.Min.Y++
+	}
+	for r.Max.Y > r.Min.Y && row(r.Max.Y-1) {
+		r.Max.Y--
+	}
+	for r.Min.X < r.Max.X && col(r.Min.X, r.Min.Y, r.Max.Y) {
+		r.Min.X++
+	}
+	for r.Max.X > r.Min.X && col(r.Max.X-1, r.Min.Y, r.Max.Y) {
+		r.Max.X--
+	}
+	return r
+}
+
+func diff(a, b uint32) uint32 {
+	if a > b {
+		return a - b
+	}
+	return b - a
+}
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+	"strings"
+)
+
+// Watermark fetches mark from origin and composites it onto img, resampled
+// with filter. mark-w and mark-pad are in the pixels of img, multiplied
+// by factor.
+//
+// mark-w wins over mark-scale, which is a percentage of the width of img.
+func Watermark(img image.Image, origin Origin, o *Options, factor float64, filter string) (image.Image, error) {
+	if o.Mark == "" || o.MarkAlpha == 0 {
+		return img, nil
+	}
+	mark, _, err := Fetch(origin, o.Mark)
+	if err != nil {
+		return nil, err
+	}
+
+	b := img.Bounds()
+	mb := mark.Bounds()
+	width := float64(mb.Dx()) * factor
+	switch {
+	case o.MarkWidth > 0:
+		width = float64(o.MarkWidth) * factor
+	case o.MarkScale > 0:
+		width = float64(b.Dx()*o.MarkScale) / 100
+	}
+	size := roundPoint(width, width*float64(mb.Dy())/float64(mb.Dx()))
+	mark = Resize(mark, size, filter)
+
+	pad := round(float64(o.MarkPad) * factor)
+	at := alignIn(b.Inset(pad), size, o.MarkAlign)
+
+	dst := toNRGBA(img)
+	r := image.Rectangle{at, at.Add(size)}.Sub(b.Min)
+	alpha := image.NewUniform(color.Alpha{uint8(o.MarkAlpha * 255 / 100)})
+	draw.DrawMask(dst, r, mark, mark.Bounds().Min, alpha, image.Point{}, draw.Over)
+	return dst, nil
+}
+
+// alignIn returns where a box of size goes in r for an align value such
+// as "bottom,right". Unnamed axes are centered.
+func alignIn(r image.Rectangle, size image.Point, align string) image.Point {
+	at := r.Min.Add(r.Size().Sub(size).Div(2))
+	for _, a := range strings.Split(align, ",") {
+		switch a {
+		case "top":
+			at.Y = r.Min.Y
+		case "bottom":
+			at.Y = r.Max.Y - size.Y
+		case "left":
+			at.X = r.Min.X
+		case "right":
+			at.X = r.Max.X - size.X
+		}
+	}
+	return at
+}
@@ -0,0 +1,81 @@
+package main
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// writePNG saves img as name in dir, for tests that read from an Origin.
+func writePNG(t *testing.T, dir, name string, img image.Image) {
+	t.Helper()
+	f, err := os.Create(filepath.Join(dir, name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	if err := png.Encode(f, img); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestOverlayFilter checks that mark and mask images are resampled with
+// the server default filter, like the image itself.
+func TestOverlayFilter(t *testing.T) {
+	dir := t.TempDir()
+	checker := image.NewNRGBA(image.Rect(0, 0, 7, 5))
+	for y := 0; y < 5; y++ {
+		for x := 0; x < 7; x++ {
+			a := uint8(0xff)
+			if (x+y)%2 == 0 {
+				a = 0x20
+			}
+			checker.SetNRGBA(x, y, color.NRGBA{0xff * uint8(x%2), 0, 0xff, a})
+		}
+	}
+	writePNG(t, dir, "m.png", checker)
+	opaque := image.NewNRGBA(image.Rect(0, 0, 30, 20))
+	for i := range opaque.Pix {
+		opaque.Pix[i] = 0xff
+	}
+
+	for _, filter := range []string{"nearest", "bilinear"} {
+		e := &Engine{Config: DefaultConfig, Origin: Dir(dir)}
+		e.Config.Filter = filter
+
+		o, err := parseOptions("?mark=m.png&mark-w=21&mark-pad=0&mark-align=top,left")
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := e.Transform(testImage(60, 40), o)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := testImage(60, 40)
+		mark := Resize(checker, image.Pt(21, 15), filter)
+		draw.DrawMask(want, image.Rect(0, 0, 21, 15), mark, image.Point{}, image.NewUniform(color.Alpha{0xff}), image.Point{}, draw.Over)
+		if string(toNRGBA(got).Pix) != string(want.Pix) {
+			t.Errorf("%s: mark was not resampled with the server filter", filter)
+		}
+
+		o, err = parseOptions("?mask=m.png")
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err = e.Transform(opaque, o)
+		if err != nil {
+			t.Fatal(err)
+		}
+		mask := toNRGBA(Resize(checker, opaque.Rect.Size(), filter))
+		g := toNRGBA(got)
+		for i := 3; i < len(g.Pix); i += 4 {
+			if g.Pix[i] != mask.Pix[i] {
+				t.Fatalf("%s: alpha of pixel %d is %d, want %d from the resampled mask", filter, i/4, g.Pix[i], mask.Pix[i])
+			}
+		}
+	}
+}
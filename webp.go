@@ -0,0 +1,563 @@
+package main
+
+import (
+	"container/heap"
+	"encoding/binary"
+	"errors"
+	"image"
+	"io"
+	"sort"
+)
+
+// EncodeWebP writes img as a lossless WebP (VP8L). golang.org/x/image only
+// decodes WebP, so this is a small encoder of its own: the subtract green
+// and predictor transforms, then the pixels under one set of Huffman
+// codes with backward references for runs, but no color cache.
+func EncodeWebP(w io.Writer, img image.Image) error {
+	src := toNRGBA(img)
+	width, height := src.Rect.Dx(), src.Rect.Dy()
+	if width > 1<<14 || height > 1<<14 {
+		return errors.New("webp: image is too large")
+	}
+
+	opaque := true
+	pix := append([]byte(nil), src.Pix...)
+	for i := 0; i < len(pix); i += 4 {
+		pix[i] -= pix[i+1]
+		pix[i+2] -= pix[i+1]
+		opaque = opaque && pix[i+3] == 0xff
+	}
+
+	bw := &bitWriter{}
+	bw.write(0x2f, 8)
+	bw.write(uint32(width-1), 14)
+	bw.write(uint32(height-1), 14)
+	if opaque {
+		bw.write(0, 1)
+	} else {
+		bw.write(1, 1)
+	}
+	bw.write(0, 3)
+
+	// subtract green
+	bw.write(1, 1)
+	bw.write(2, 2)
+
+	// predictor
+	modes, residuals := predict(pix, width, height)
+	bw.write(1, 1)
+	bw.write(0, 2)
+	bw.write(predictorBits-2, 3)
+	writeEntropyImage(bw, modes, (width+1<<predictorBits-1)>>predictorBits, false)
+
+	bw.write(0, 1)
+	writeEntropyImage(bw, residuals, width, true)
+	bw.flush()
+
+	data := bw.buf
+	size := len(data)
+	var head [20]byte
+	copy(head[0:], "RIFF")
+	binary.LittleEndian.PutUint32(head[4:], uint32(4+8+size+size&1))
+	copy(head[8:], "WEBPVP8L")
+	binary.LittleEndian.PutUint32(head[16:], uint32(size))
+	if _, err := w.Write(head[:]); err != nil {
+		return err
+	}
+	if size&1 != 0 {
+		data = append(data, 0)
+	}
+	_, err := w.Write(data)
+	return err
+}
+
+// predictorBits is the log2 side of the predictor tiles.
+const predictorBits = 4
+
+// predict picks the predictor mode of each tile that leaves the smallest
+// residuals and returns the mode image and the residuals, both RGBA.
+func predict(pix []byte, w, h int) ([]byte, []byte) {
+	tile := 1 << predictorBits
+	tw, th := (w+tile-1)/tile, (h+tile-1)/tile
+	modes := make([]byte, tw*th*4)
+	for ty := 0; ty < th; ty++ {
+		for tx := 0; tx < tw; tx++ {
+			best, cost := 0, -1
+			for mode := 0; mode < 14; mode++ {
+				c := 0
+				for y := ty * tile; y < h && y < (ty+1)*tile; y++ {
+					for x := tx * tile; x < w && x < (tx+1)*tile; x++ {
+						p := (y*w + x) * 4
+						pred := predictPixel(pix, p, p-w*4, x, y, mode)
+						for k := 0; k < 4; k++ {
+							d := int(int8(pix[p+k] - pred[k]))
+							if d < 0 {
+								d = -d
+							}
+							c += d
+						}
+					}
+				}
+				if cost < 0 || c < cost {
+					best, cost = mode, c
+				}
+			}
+			modes[(ty*tw+tx)*4+1] = byte(best)
+		}
+	}
+
+	residuals := make([]byte, len(pix))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			p := (y*w + x) * 4
+			mode := int(modes[((y/tile)*tw+x/tile)*4+1])
+			pred := predictPixel(pix, p, p-w*4, x, y, mode)
+			for k := 0; k < 4; k++ {
+				residuals[p+k] = pix[p+k] - pred[k]
+			}
+		}
+	}
+	return modes, residuals
+}
+
+// predictPixel predicts the pixel at p from its neighbors; top is the
+// offset of the pixel above. The first row always predicts from the
+// left and the first column from above.
+func predictPixel(pix []byte, p, top, x, y, mode int) [4]byte {
+	var out [4]byte
+	switch {
+	case x == 0 && y == 0:
+		out[3] = 0xff
+		return out
+	case y == 0:
+		mode = 1
+	case x == 0:
+		mode = 2
+	}
+	if mode == 11 {
+		l, t := 0, 0
+		for k := 0; k < 4; k++ {
+			l += absInt(int(pix[top-4+k]) - int(pix[top+k]))
+			t += absInt(int(pix[top-4+k]) - int(pix[p-4+k]))
+		}
+		from := top
+		if l < t {
+			from = p - 4
+		}
+		copy(out[:], pix[from:from+4])
+		return out
+	}
+	for k := 0; k < 4; k++ {
+		var L, T, TR, TL byte
+		L = pix[p-4+k]
+		if y > 0 {
+			T, TR = pix[top+k], pix[top+4+k]
+			if x > 0 {
+				TL = pix[top-4+k]
+			}
+		}
+		switch mode {
+		case 0:
+			if k == 3 {
+				out[k] = 0xff
+			}
+		case 1:
+			out[k] = L
+		case 2:
+			out[k] = T
+		case 3:
+			out[k] = TR
+		case 4:
+			out[k] = TL
+		case 5:
+			out[k] = avg2(avg2(L, TR), T)
+		case 6:
+			out[k] = avg2(L, TL)
+		case 7:
+			out[k] = avg2(L, T)
+		case 8:
+			out[k] = avg2(TL, T)
+		case 9:
+			out[k] = avg2(T, TR)
+		case 10:
+			out[k] = avg2(avg2(L, TL), avg2(T, TR))
+		case 12:
+			out[k] = clampByte(int(L) + int(T) - int(TL))
+		case 13:
+			a := int(avg2(L, T))
+			out[k] = clampByte(a + (a-int(TL))/2)
+		}
+	}
+	return out
+}
+
+func avg2(a, b byte) byte {
+	return byte((int(a) + int(b)) / 2)
+}
+
+func clampByte(v int) byte {
+	if v < 0 {
+		return 0
+	}
+	if v > 255 {
+		return 255
+	}
+	return byte(v)
+}
+
+func absInt(v int) int {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
+
+// Backward references copy a run of pixels from the left neighbor or
+// the row above; shorter runs are cheaper as literals.
+const (
+	minCopy = 3
+	maxCopy = 4096
+	// planeLeft and planeUp are the VP8L distance codes of the pixel to
+	// the left and the one above.
+	planeLeft = 2
+	planeUp   = 1
+)
+
+// webpToken is a literal pixel or a backward reference.
+type webpToken struct {
+	pix      [4]byte
+	length   int // 0 for a literal
+	distance int // plane code
+}
+
+// writeEntropyImage writes RGBA pix, w pixels wide: the color cache bit,
+// for the main image the meta prefix bit, then the five prefix codes and
+// the pixels.
+func writeEntropyImage(bw *bitWriter, pix []byte, w int, main bool) {
+	bw.write(0, 1)
+	if main {
+		bw.write(0, 1)
+	}
+
+	tokens := tokenize(pix, w)
+	var freq [5][]int
+	for i := range freq {
+		freq[i] = make([]int, 256)
+	}
+	freq[0] = make([]int, 256+24)
+	freq[4] = make([]int, 40)
+	for _, t := range tokens {
+		if t.length == 0 {
+			freq[0][t.pix[1]]++
+			freq[1][t.pix[0]]++
+			freq[2][t.pix[2]]++
+			freq[3][t.pix[3]]++
+			continue
+		}
+		lp, _, _ := prefixEncode(t.length)
+		dp, _, _ := prefixEncode(t.distance)
+		freq[0][256+lp]++
+		freq[4][dp]++
+	}
+	var codes [5]*prefixCode
+	for i, f := range freq {
+		codes[i] = newPrefixCode(f, 15)
+		codes[i].writeHeader(bw)
+	}
+
+	for _, t := range tokens {
+		if t.length == 0 {
+			codes[0].emit(bw, int(t.pix[1]))
+			codes[1].emit(bw, int(t.pix[0]))
+			codes[2].emit(bw, int(t.pix[2]))
+			codes[3].emit(bw, int(t.pix[3]))
+			continue
+		}
+		lp, lextra, lbits := prefixEncode(t.length)
+		codes[0].emit(bw, 256+lp)
+		bw.write(uint32(lextra), uint(lbits))
+		dp, dextra, dbits := prefixEncode(t.distance)
+		codes[4].emit(bw, dp)
+		bw.write(uint32(dextra), uint(dbits))
+	}
+}
+
+// tokenize turns pix into literals and greedy copies of the longest run
+// matching the pixel to the left or the row above.
+func tokenize(pix []byte, w int) []webpToken {
+	n := len(pix) / 4
+	same := func(i, j int) bool {
+		return pix[i*4] == pix[j*4] && pix[i*4+1] == pix[j*4+1] &&
+			pix[i*4+2] == pix[j*4+2] && pix[i*4+3] == pix[j*4+3]
+	}
+	run := func(i, dist int) int {
+		if i < dist {
+			return 0
+		}
+		k := 0
+		for i+k < n && k < maxCopy && same(i+k, i+k-dist) {
+			k++
+		}
+		return k
+	}
+
+	var tokens []webpToken
+	for i := 0; i < n; {
+		left, up := run(i, 1), run(i, w)
+		switch {
+		case left >= minCopy && left >= up:
+			tokens = append(tokens, webpToken{length: left, distance: planeLeft})
+			i += left
+		case up >= minCopy:
+			tokens = append(tokens, webpToken{length: up, distance: planeUp})
+			i += up
+		default:
+			var t webpToken
+			copy(t.pix[:], pix[i*4:i*4+4])
+			tokens = append(tokens, t)
+			i++
+		}
+	}
+	return tokens
+}
+
+// prefixEncode splits a length or distance code v >= 1 into its prefix
+// symbol and extra bits.
+func prefixEncode(v int) (prefix, extra, bits int) {
+	d := v - 1
+	if d < 4 {
+		return d, 0, 0
+	}
+	h := 0
+	for d>>uint(h+1) != 0 {
+		h++
+	}
+	second := (d >> uint(h-1)) & 1
+	bits = h - 1
+	return 2*h + second, d & (1<<uint(bits) - 1), bits
+}
+
+// prefixCode is a canonical Huffman code as VP8L stores it.
+type prefixCode struct {
+	lengths []int
+	codes   []uint32
+	used    []int // symbols with a code
+}
+
+func newPrefixCode(freq []int, limit int) *prefixCode {
+	c := &prefixCode{lengths: huffmanLengths(freq, limit)}
+	for s, n := range c.lengths {
+		if n > 0 {
+			c.used = append(c.used, s)
+		}
+	}
+	c.codes = canonicalCodes(c.lengths)
+	return c
+}
+
+// emit writes symbol s. A code of a single symbol takes no bits.
+func (c *prefixCode) emit(bw *bitWriter, s int) {
+	if len(c.used) <= 1 {
+		return
+	}
+	n := uint(c.lengths[s])
+	bw.write(reverseBits(c.codes[s], n), n)
+}
+
+var codeLengthOrder = [19]int{17, 18, 0, 1, 2, 3, 4, 5, 16, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}
+
+// writeHeader writes the code: as a simple code when it has at most one
+// 8 bit symbol, else as code lengths, runs of zeros folded into the
+// repeat codes 17 and 18.
+func (c *prefixCode) writeHeader(bw *bitWriter) {
+	if len(c.used) <= 1 {
+		s := 0
+		if len(c.used) == 1 {
+			s = c.used[0]
+		}
+		bw.write(1, 1)
+		bw.write(0, 1)
+		bw.write(1, 1)
+		bw.write(uint32(s), 8)
+		return
+	}
+
+	type token struct{ sym, extra, bits int }
+	var tokens []token
+	for i := 0; i < len(c.lengths); {
+		if c.lengths[i] != 0 {
+			tokens = append(tokens, token{c.lengths[i], 0, 0})
+			i++
+			continue
+		}
+		run := 0
+		for i+run < len(c.lengths) && c.lengths[i+run] == 0 && run < 138 {
+			run++
+		}
+		switch {
+		case run >= 11:
+			tokens = append(tokens, token{18, run - 11, 7})
+		case run >= 3:
+			tokens = append(tokens, token{17, run - 3, 3})
+		default:
+			for k := 0; k < run; k++ {
+				tokens = append(tokens, token{0, 0, 0})
+			}
+		}
+		i += run
+	}
+
+	freq := make([]int, 19)
+	for _, t := range tokens {
+		freq[t.sym]++
+	}
+	lc := newPrefixCode(freq, 7)
+	n := 19
+	for n > 4 && lc.lengths[codeLengthOrder[n-1]] == 0 {
+		n--
+	}
+	bw.write(0, 1)
+	bw.write(uint32(n-4), 4)
+	for _, s := range codeLengthOrder[:n] {
+		bw.write(uint32(lc.lengths[s]), 3)
+	}
+	bw.write(0, 1)
+	for _, t := range tokens {
+		lc.emit(bw, t.sym)
+		if t.bits > 0 {
+			bw.write(uint32(t.extra), uint(t.bits))
+		}
+	}
+}
+
+// huffmanLengths returns code lengths for freq of at most limit bits,
+// flattening the frequencies until the tree is shallow enough. A single
+// used symbol gets length 1.
+func huffmanLengths(freq []int, limit int) []int {
+	f := append([]int(nil), freq...)
+	for {
+		lengths := treeLengths(f)
+		max := 0
+		for _, n := range lengths {
+			if n > max {
+				max = n
+			}
+		}
+		if max <= limit {
+			return lengths
+		}
+		for i := range f {
+			if f[i] > 0 {
+				f[i] = f[i]/2 + 1
+			}
+		}
+	}
+}
+
+type huffNode struct {
+	weight      int
+	symbol      int // -1 for inner nodes
+	left, right *huffNode
+}
+
+type huffHeap []*huffNode
+
+func (h huffHeap) Len() int { return len(h) }
+func (h huffHeap) Less(i, j int) bool {
+	if h[i].weight != h[j].weight {
+		return h[i].weight < h[j].weight
+	}
+	return h[i].symbol < h[j].symbol
+}
+func (h huffHeap) Swap(i, j int)         { h[i], h[j] = h[j], h[i] }
+func (h *huffHeap) Push(x interface{})   { *h = append(*h, x.(*huffNode)) }
+func (h *huffHeap) Pop() (x interface{}) { x, *h = (*h)[len(*h)-1], (*h)[:len(*h)-1]; return x }
+
+func treeLengths(freq []int) []int {
+	lengths := make([]int, len(freq))
+	h := &huffHeap{}
+	for s, f := range freq {
+		if f > 0 {
+			*h = append(*h, &huffNode{weight: f, symbol: s})
+		}
+	}
+	switch h.Len() {
+	case 0:
+		return lengths
+	case 1:
+		lengths[(*h)[0].symbol] = 1
+		return lengths
+	}
+	heap.Init(h)
+	for h.Len() > 1 {
+		a := heap.Pop(h).(*huffNode)
+		b := heap.Pop(h).(*huffNode)
+		heap.Push(h, &huffNode{weight: a.weight + b.weight, symbol: -1, left: a, right: b})
+	}
+	var walk func(n *huffNode, depth int)
+	walk = func(n *huffNode, depth int) {
+		if n.symbol >= 0 {
+			lengths[n.symbol] = depth
+			return
+		}
+		walk(n.left, depth+1)
+		walk(n.right, depth+1)
+	}
+	walk((*h)[0], 0)
+	return lengths
+}
+
+// canonicalCodes assigns codes in order of length, then symbol.
+func canonicalCodes(lengths []int) []uint32 {
+	symbols := make([]int, 0, len(lengths))
+	for s, n := range lengths {
+		if n > 0 {
+			symbols = append(symbols, s)
+		}
+	}
+	sort.SliceStable(symbols, func(i, j int) bool {
+		return lengths[symbols[i]] < lengths[symbols[j]]
+	})
+	codes := make([]uint32, len(lengths))
+	code, prev := uint32(0), 0
+	for _, s := range symbols {
+		code <<= uint(lengths[s] - prev)
+		prev = lengths[s]
+		codes[s] = code
+		code++
+	}
+	return codes
+}
+
+func reverseBits(v uint32, n uint) uint32 {
+	var r uint32
+	for i := uint(0); i < n; i++ {
+		r = r<<1 | v&1
+		v >>= 1
+	}
+	return r
+}
+
+// bitWriter packs bits least significant first, as VP8L reads them.
+type bitWriter struct {
+	buf  []byte
+	acc  uint64
+	nacc uint
+}
+
+func (b *bitWriter) write(v uint32, n uint) {
+	b.acc |= uint64(v) << b.nacc
+	b.nacc += n
+	for b.nacc >= 8 {
+		b.buf = append(b.buf, byte(b.acc))
+		b.acc >>= 8
+		b.nacc -= 8
+	}
+}
+
+func (b *bitWriter) flush() {
+	if b.nacc > 0 {
+		b.buf = append(b.buf, byte(b.acc))
+		b.acc, b.nacc = 0, 0
+	}
+}
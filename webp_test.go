@@ -0,0 +1,122 @@
+package main
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"net/http/httptest"
+	"testing"
+
+	"golang.org/x/image/webp"
+)
+
+// webpRoundTrip encodes img and decodes it back with x/image/webp.
+func webpRoundTrip(t *testing.T, img image.Image) *image.NRGBA {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := EncodeWebP(&buf, img); err != nil {
+		t.Fatal(err)
+	}
+	got, err := webp.Decode(&buf)
+	if err != nil {
+		t.Fatalf("%v: decode: %v", img.Bounds().Size(), err)
+	}
+	return toNRGBA(got)
+}
+
+// sameVisible reports whether a and b match on every pixel, ignoring the
+// color of fully transparent ones.
+func sameVisible(a, b *image.NRGBA) bool {
+	if a.Rect != b.Rect {
+		return false
+	}
+	for i := 0; i < len(a.Pix); i += 4 {
+		if a.Pix[i+3] != b.Pix[i+3] {
+			return false
+		}
+		if a.Pix[i+3] != 0 && !bytes.Equal(a.Pix[i:i+3], b.Pix[i:i+3]) {
+			return false
+		}
+	}
+	return true
+}
+
+func TestWebPRoundTrip(t *testing.T) {
+	noise := func(w, h int) *image.NRGBA {
+		img := image.NewNRGBA(image.Rect(0, 0, w, h))
+		seed := uint32(1)
+		for i := range img.Pix {
+			seed = seed*1664525 + 1013904223
+			img.Pix[i] = uint8(seed >> 24)
+		}
+		return img
+	}
+	sizes := []image.Point{{1, 1}, {2, 1}, {1, 7}, {15, 15}, {17, 33}, {100, 3}, {129, 67}}
+	for _, size := range sizes {
+		for name, img := range map[string]*image.NRGBA{
+			"gradient": testImage(size.X, size.Y),
+			"opaque":   toNRGBA(Flatten(testImage(size.X, size.Y), nil)),
+			"noise":    noise(size.X, size.Y),
+			"single":   uniform(size.X, size.Y, color.NRGBA{0x12, 0x34, 0x56, 0xff}),
+			"clear":    uniform(size.X, size.Y, color.NRGBA{}),
+		} {
+			if got := webpRoundTrip(t, img); !sameVisible(got, img) {
+				t.Errorf("%s %v: pixels changed in the round trip", name, size)
+			}
+		}
+	}
+}
+
+// TestWebPSubImage encodes a view into a larger image, which has a
+// stride wider than its width and bounds that do not start at zero.
+func TestWebPSubImage(t *testing.T) {
+	img := testImage(40, 30).SubImage(image.Rect(5, 7, 28, 20))
+	if got := webpRoundTrip(t, img); !sameVisible(got, toNRGBA(img)) {
+		t.Error("pixels changed in the round trip")
+	}
+}
+
+func TestWebPCompressesFlatImages(t *testing.T) {
+	var buf bytes.Buffer
+	if err := EncodeWebP(&buf, uniform(512, 512, color.NRGBA{0x80, 0x80, 0x80, 0xff})); err != nil {
+		t.Fatal(err)
+	}
+	if buf.Len() > 1024 {
+		t.Errorf("a single color 512x512 image takes %d bytes", buf.Len())
+	}
+}
+
+// TestWebPQuality checks quality is refused for webp output, which is
+// lossless, unless format=auto chose webp on its own.
+func TestWebPQuality(t *testing.T) {
+	dir := t.TempDir()
+	writePNG(t, dir, "a.png", testImage(8, 6))
+	h := &Handler{Engine: &Engine{Config: DefaultConfig, Origin: Dir(dir)}}
+	tests := []struct {
+		url, accept string
+		code        int
+	}{
+		{"/a.png?format=webp", "", 200},
+		{"/a.png?format=webp&q=50", "", 400},
+		{"/a.png?format=webp&q=50&max-bytes=100000", "", 400},
+		{"/a.png?format=auto&q=50", "image/webp", 200},
+	}
+	for _, tt := range tests {
+		r := httptest.NewRequest("GET", tt.url, nil)
+		r.Header.Set("Accept", tt.accept)
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, r)
+		if rec.Code != tt.code {
+			t.Errorf("%s: status %d, want %d: %s", tt.url, rec.Code, tt.code, rec.Body)
+		}
+	}
+
+	o, err := parseOptions("?format=auto&q=50")
+	if err != nil {
+		t.Fatal(err)
+	}
+	src := &Source{Image: testImage(8, 6), Format: "png"}
+	if f := AutoFormat("image/webp", src, o); f.Name != "webp" || o.Quality != 0 || len(o.Diagnostics) != 1 {
+		t.Errorf("format=auto&q=50: %s with quality %d and diagnostics %q", f.Name, o.Quality, o.Diagnostics)
+	}
+}